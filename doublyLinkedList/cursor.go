@@ -0,0 +1,105 @@
+package doublyLinkedList
+
+import (
+	"errors"
+)
+
+// Cursor walks a DoublyLinkedList in both directions. Besides the nodes of
+// the list, a Cursor can rest on a "ghost" position which sits after the last
+// and before the first element, so moving past either end wraps through it.
+// Elements can be inserted or removed through the Cursor while iterating. If
+// the current node is removed by other means, the Cursor is stranded: it no
+// longer points to an element, cannot move, and cannot insert or remove. As
+// Next then returns false just like at the end of the list, a loop over Next
+// should check Stranded afterwards
+type Cursor[T any] struct {
+	list *DoublyLinkedList[T]
+	// node is the current node, or the root of the list for the ghost position
+	node *Node[T]
+}
+
+// Next moves the cursor to the next element and returns whether it landed on
+// an element, false means the cursor moved past the end to the ghost position
+// or is stranded
+func (cursor *Cursor[T]) Next() bool {
+	if cursor.Stranded() {
+		return false
+	}
+	cursor.node = cursor.node.next
+	return cursor.Valid()
+}
+
+// Prev moves the cursor to the previous element and returns whether it landed
+// on an element, false means the cursor moved past the front to the ghost
+// position or is stranded
+func (cursor *Cursor[T]) Prev() bool {
+	if cursor.Stranded() {
+		return false
+	}
+	cursor.node = cursor.node.prev
+	return cursor.Valid()
+}
+
+// Valid returns whether the cursor currently points to an element
+func (cursor *Cursor[T]) Valid() bool {
+	return cursor.node != nil && cursor.node.list == cursor.list
+}
+
+// Stranded returns whether the current node has been removed from the list
+// by other means than the cursor
+func (cursor *Cursor[T]) Stranded() bool {
+	return cursor.node != cursor.list.root && !cursor.Valid()
+}
+
+// Node returns the pointer to the current Node, nil at the ghost position or
+// if the cursor is stranded
+func (cursor *Cursor[T]) Node() *Node[T] {
+	if !cursor.Valid() {
+		return nil
+	}
+	return cursor.node
+}
+
+// Val returns the current element, second returned value will be false at
+// the ghost position or if the cursor is stranded
+func (cursor *Cursor[T]) Val() (T, bool) {
+	if !cursor.Valid() {
+		var zero T
+		return zero, false
+	}
+	return cursor.node.data, true
+}
+
+// InsertBefore inserts the provided data before the current element, or at
+// the end of the list at the ghost position. The cursor does not move.
+// Returns nil without inserting if the cursor is stranded
+func (cursor *Cursor[T]) InsertBefore(data T) *Node[T] {
+	if cursor.Stranded() {
+		return nil
+	}
+	return cursor.list.insertAfter(cursor.node.prev, data)
+}
+
+// InsertAfter inserts the provided data after the current element, or at the
+// front of the list at the ghost position. The cursor does not move.
+// Returns nil without inserting if the cursor is stranded
+func (cursor *Cursor[T]) InsertAfter(data T) *Node[T] {
+	if cursor.Stranded() {
+		return nil
+	}
+	return cursor.list.insertAfter(cursor.node, data)
+}
+
+// Remove removes the current element and returns it, error at the ghost
+// position or if the cursor is stranded. The cursor moves back to the
+// previous position, so the next call to Next visits the element which
+// followed the removed one
+func (cursor *Cursor[T]) Remove() (T, error) {
+	if !cursor.Valid() {
+		var zero T
+		return zero, errors.New("Cursor does not point to an element")
+	}
+	node := cursor.node
+	cursor.node = node.prev
+	return cursor.list.remove(node), nil
+}
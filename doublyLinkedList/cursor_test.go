@@ -0,0 +1,182 @@
+package doublyLinkedList
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newListOf(vals ...int) *DoublyLinkedList[int] {
+	list := New[int]()
+	for _, val := range vals {
+		list.PushBack(val)
+	}
+	return list
+}
+
+func TestCursorForward(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newListOf(1, 2, 3)
+	cursor := list.Cursor()
+	assert.Equal(false, cursor.Valid())
+
+	vals := []int{}
+	for cursor.Next() {
+		val, ok := cursor.Val()
+		assert.Equal(true, ok)
+		vals = append(vals, val)
+	}
+	assert.Equal([]int{1, 2, 3}, vals)
+
+	val, ok := cursor.Val()
+	assert.Equal(0, val)
+	assert.Equal(false, ok)
+	assert.Nil(cursor.Node())
+
+	// Moving past the ghost position wraps around to the front
+	assert.Equal(true, cursor.Next())
+	assert.Equal(list.Front(), cursor.Node())
+}
+
+func TestCursorBackward(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newListOf(1, 2, 3)
+	cursor := list.Cursor()
+
+	vals := []int{}
+	for cursor.Prev() {
+		val, _ := cursor.Val()
+		vals = append(vals, val)
+	}
+	assert.Equal([]int{3, 2, 1}, vals)
+}
+
+func TestCursorAt(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newListOf(1, 2, 3)
+	cursor, err := list.CursorAt(list.Front().Next())
+	assert.Nil(err)
+	val, _ := cursor.Val()
+	assert.Equal(2, val)
+
+	cursor.Prev()
+	val, _ = cursor.Val()
+	assert.Equal(1, val)
+
+	cursor, err = list.CursorAt(newListOf(1).Front())
+	assert.Nil(cursor)
+	assert.Equal("Node does not belong to the list", err.Error())
+}
+
+func TestCursorRemoveWhileIterating(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newListOf(1, 2, 3, 4, 5, 6)
+	cursor := list.Cursor()
+	for cursor.Next() {
+		if val, _ := cursor.Val(); val%2 == 0 {
+			removed, err := cursor.Remove()
+			assert.Equal(val, removed)
+			assert.Nil(err)
+		}
+	}
+	assertLinks(assert, list, []int{1, 3, 5})
+
+	for cursor.Prev() {
+		if val, _ := cursor.Val(); val != 3 {
+			cursor.Remove()
+		}
+	}
+	assertLinks(assert, list, []int{3})
+
+	_, err := list.Cursor().Remove()
+	assert.Equal("Cursor does not point to an element", err.Error())
+}
+
+func TestCursorRemoveAll(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newListOf(1, 2, 3)
+	cursor := list.Cursor()
+	for cursor.Next() {
+		cursor.Remove()
+	}
+	assertLinks(assert, list, []int{})
+}
+
+func TestCursorInsertWhileIterating(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newListOf(1, 3, 5)
+	cursor := list.Cursor()
+	for cursor.Next() {
+		val, _ := cursor.Val()
+		cursor.InsertAfter(val + 1)
+		// Skip over the element just inserted
+		cursor.Next()
+	}
+	assertLinks(assert, list, []int{1, 2, 3, 4, 5, 6})
+
+	cursor, _ = list.CursorAt(list.Front())
+	cursor.InsertBefore(0)
+	assertLinks(assert, list, []int{0, 1, 2, 3, 4, 5, 6})
+
+	// At the ghost position, InsertBefore appends and InsertAfter prepends
+	cursor = list.Cursor()
+	cursor.InsertBefore(7)
+	cursor.InsertAfter(-1)
+	assertLinks(assert, list, []int{-1, 0, 1, 2, 3, 4, 5, 6, 7})
+}
+
+func TestCursorNodeRemovedFromList(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newListOf(1, 2, 3)
+	node := list.Front().Next()
+	cursor, _ := list.CursorAt(node)
+	list.Remove(node)
+
+	assert.Equal(true, cursor.Stranded())
+	assert.Equal(false, cursor.Valid())
+	assert.Nil(cursor.Node())
+	val, ok := cursor.Val()
+	assert.Equal(0, val)
+	assert.Equal(false, ok)
+	assert.Equal(false, cursor.Next())
+	assert.Equal(false, cursor.Prev())
+	assert.Nil(cursor.InsertBefore(4))
+	assert.Nil(cursor.InsertAfter(4))
+	_, err := cursor.Remove()
+	assert.Equal("Cursor does not point to an element", err.Error())
+	assert.Equal("[1 3]", list.String())
+
+	// A node put back in the list is a new node, the cursor stays stranded
+	list.PushBack(2)
+	assert.Equal(false, cursor.Next())
+	assert.Equal(true, cursor.Stranded())
+}
+
+func TestCursorStrandedStopsLoop(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newListOf(1, 2, 3)
+	cursor := list.Cursor()
+	assert.Equal(false, cursor.Stranded())
+	for cursor.Next() {
+	}
+	assert.Equal(false, cursor.Stranded())
+
+	var visited []int
+	for cursor.Next() {
+		val, _ := cursor.Val()
+		visited = append(visited, val)
+		if val == 2 {
+			list.Remove(cursor.Node())
+		}
+	}
+	assert.Equal([]int{1, 2}, visited)
+	assert.Equal(true, cursor.Stranded())
+}
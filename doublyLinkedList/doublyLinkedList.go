@@ -0,0 +1,160 @@
+package doublyLinkedList
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+)
+
+// DoublyLinkedList is a list whose nodes link to both of their neighbours, so
+// that nodes can be inserted or removed in O(1) given the node itself
+type DoublyLinkedList[T any] struct {
+	// root is a sentinel closing the nodes into a ring, root.next is the
+	// first node and root.prev is the last node
+	root *Node[T]
+	size uint
+}
+
+// New creates and returns an empty DoublyLinkedList
+func New[T any]() *DoublyLinkedList[T] {
+	list := &DoublyLinkedList[T]{root: &Node[T]{}}
+	list.root.prev = list.root
+	list.root.next = list.root
+	return list
+}
+
+// Front returns the pointer to the first Node, nil if the list is empty
+func (list *DoublyLinkedList[T]) Front() *Node[T] {
+	if list.size == 0 {
+		return nil
+	}
+	return list.root.next
+}
+
+// Back returns the pointer to the last Node, nil if the list is empty
+func (list *DoublyLinkedList[T]) Back() *Node[T] {
+	if list.size == 0 {
+		return nil
+	}
+	return list.root.prev
+}
+
+// IsEmpty returns whether the list is empty
+func (list *DoublyLinkedList[T]) IsEmpty() bool {
+	return list.size == 0
+}
+
+// Size returns the number of element(s) in the list
+func (list *DoublyLinkedList[T]) Size() uint {
+	return list.size
+}
+
+// PushFront inserts the provided data to the front of the list and returns
+// the pointer to the Node just inserted
+func (list *DoublyLinkedList[T]) PushFront(data T) *Node[T] {
+	return list.insertAfter(list.root, data)
+}
+
+// PushBack inserts the provided data to the end of the list and returns the
+// pointer to the Node just inserted
+func (list *DoublyLinkedList[T]) PushBack(data T) *Node[T] {
+	return list.insertAfter(list.root.prev, data)
+}
+
+// PopFront removes and returns the first element, error if the list is empty
+func (list *DoublyLinkedList[T]) PopFront() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, errors.New("List is empty")
+	}
+	return list.remove(list.root.next), nil
+}
+
+// PopBack removes and returns the last element, error if the list is empty
+func (list *DoublyLinkedList[T]) PopBack() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, errors.New("List is empty")
+	}
+	return list.remove(list.root.prev), nil
+}
+
+// InsertBefore inserts the provided data right before the specified node and
+// returns the pointer to the Node just inserted, error if the node does not
+// belong to the list
+func (list *DoublyLinkedList[T]) InsertBefore(node *Node[T], data T) (*Node[T], error) {
+	if node == nil || node.list != list {
+		return nil, errors.New("Node does not belong to the list")
+	}
+	return list.insertAfter(node.prev, data), nil
+}
+
+// InsertAfter inserts the provided data right after the specified node and
+// returns the pointer to the Node just inserted, error if the node does not
+// belong to the list
+func (list *DoublyLinkedList[T]) InsertAfter(node *Node[T], data T) (*Node[T], error) {
+	if node == nil || node.list != list {
+		return nil, errors.New("Node does not belong to the list")
+	}
+	return list.insertAfter(node, data), nil
+}
+
+// Remove removes the specified node from the list in O(1) and returns its
+// element, error if the node does not belong to the list
+func (list *DoublyLinkedList[T]) Remove(node *Node[T]) (T, error) {
+	if node == nil || node.list != list {
+		var zero T
+		return zero, errors.New("Node does not belong to the list")
+	}
+	return list.remove(node), nil
+}
+
+// Cursor returns a Cursor positioned before the first and after the last
+// element, so that the first call to Next moves it to the front and the first
+// call to Prev moves it to the back
+func (list *DoublyLinkedList[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{list, list.root}
+}
+
+// CursorAt returns a Cursor positioned at the specified node, error if the
+// node does not belong to the list
+func (list *DoublyLinkedList[T]) CursorAt(node *Node[T]) (*Cursor[T], error) {
+	if node == nil || node.list != list {
+		return nil, errors.New("Node does not belong to the list")
+	}
+	return &Cursor[T]{list, node}, nil
+}
+
+func (list *DoublyLinkedList[T]) String() string {
+	var b bytes.Buffer
+	els := make([]string, 0, list.size)
+
+	b.WriteString("[")
+	for current := list.Front(); current != nil; current = current.Next() {
+		els = append(els, current.String())
+	}
+	b.WriteString(strings.Join(els, " "))
+	b.WriteString("]")
+
+	return b.String()
+}
+
+// insertAfter links a new node holding data right after previous, which must
+// be the root or a node of the list
+func (list *DoublyLinkedList[T]) insertAfter(previous *Node[T], data T) *Node[T] {
+	node := &Node[T]{data: data, prev: previous, next: previous.next, list: list}
+	previous.next.prev = node
+	previous.next = node
+	list.size++
+	return node
+}
+
+// remove unlinks node, which must be a node of the list, and returns its
+// element
+func (list *DoublyLinkedList[T]) remove(node *Node[T]) T {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.prev, node.next, node.list = nil, nil, nil
+	list.size--
+	return node.data
+}
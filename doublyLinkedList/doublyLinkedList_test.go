@@ -0,0 +1,165 @@
+package doublyLinkedList
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertLinks checks that walking the list forwards and backwards visits the
+// expected elements and that the size agrees
+func assertLinks(assert *assert.Assertions, list *DoublyLinkedList[int], expected []int) {
+	forward := []int{}
+	for node := list.Front(); node != nil; node = node.Next() {
+		forward = append(forward, node.Val())
+	}
+	backward := []int{}
+	for node := list.Back(); node != nil; node = node.Prev() {
+		backward = append([]int{node.Val()}, backward...)
+	}
+	assert.Equal(expected, forward)
+	assert.Equal(expected, backward)
+	assert.Equal(uint(len(expected)), list.Size())
+}
+
+func TestNew(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	assert.Equal(true, list.IsEmpty())
+	assert.Equal(uint(0), list.Size())
+	assert.Nil(list.Front())
+	assert.Nil(list.Back())
+	assert.Equal("[]", list.String())
+}
+
+func TestPushFront(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	node := list.PushFront(2)
+	assert.Equal(node, list.Front())
+	assert.Equal(node, list.Back())
+
+	node = list.PushFront(1) // [1 2]
+	assert.Equal(node, list.Front())
+	assertLinks(assert, list, []int{1, 2})
+}
+
+func TestPushBack(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	list.PushBack(1)
+	node := list.PushBack(2) // [1 2]
+	assert.Equal(node, list.Back())
+	assertLinks(assert, list, []int{1, 2})
+}
+
+func TestPopFront(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	val, err := list.PopFront()
+	assert.Equal(0, val)
+	assert.Equal("List is empty", err.Error())
+
+	list.PushBack(1)
+	list.PushBack(2)
+	val, err = list.PopFront()
+	assert.Equal(1, val)
+	assert.Nil(err)
+	assertLinks(assert, list, []int{2})
+}
+
+func TestPopBack(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	val, err := list.PopBack()
+	assert.Equal(0, val)
+	assert.Equal("List is empty", err.Error())
+
+	list.PushBack(1)
+	list.PushBack(2)
+	val, err = list.PopBack()
+	assert.Equal(2, val)
+	assert.Nil(err)
+	assertLinks(assert, list, []int{1})
+
+	list.PopBack()
+	assertLinks(assert, list, []int{})
+}
+
+func TestInsertBefore(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	first := list.PushBack(2)
+	node, err := list.InsertBefore(first, 1) // [1 2]
+	assert.Nil(err)
+	assert.Equal(node, list.Front())
+	assertLinks(assert, list, []int{1, 2})
+
+	node, err = New[int]().InsertBefore(first, 0)
+	assert.Nil(node)
+	assert.Equal("Node does not belong to the list", err.Error())
+}
+
+func TestInsertAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	first := list.PushBack(1)
+	list.PushBack(3)
+	_, err := list.InsertAfter(first, 2) // [1 2 3]
+	assert.Nil(err)
+	node, err := list.InsertAfter(list.Back(), 4) // [1 2 3 4]
+	assert.Nil(err)
+	assert.Equal(node, list.Back())
+	assertLinks(assert, list, []int{1, 2, 3, 4})
+
+	_, err = list.InsertAfter(nil, 0)
+	assert.Equal("Node does not belong to the list", err.Error())
+}
+
+func TestRemove(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	list.PushBack(1)
+	middle := list.PushBack(2)
+	list.PushBack(3)
+
+	val, err := list.Remove(middle) // [1 3]
+	assert.Equal(2, val)
+	assert.Nil(err)
+	assertLinks(assert, list, []int{1, 3})
+	assert.Nil(middle.Next())
+	assert.Nil(middle.Prev())
+
+	// A node cannot be removed twice
+	val, err = list.Remove(middle)
+	assert.Equal(0, val)
+	assert.Equal("Node does not belong to the list", err.Error())
+}
+
+func TestNodeSetVal(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	node := list.PushBack(1)
+	node.SetVal(2)
+	assert.Equal(2, node.Val())
+	assert.Equal("2", node.String())
+}
+
+func TestString(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[string]()
+	list.PushBack("b")
+	list.PushBack("c")
+	list.PushFront("a")
+	assert.Equal("[a b c]", list.String())
+}
@@ -0,0 +1,45 @@
+package doublyLinkedList
+
+import (
+	"fmt"
+)
+
+type Node[T any] struct {
+	data T
+	prev *Node[T]
+	next *Node[T]
+	// list is the DoublyLinkedList the node belongs to, nil once removed
+	list *DoublyLinkedList[T]
+}
+
+// Val returns the value of the Node
+func (node *Node[T]) Val() T {
+	return node.data
+}
+
+// SetVal set the provided value of the Node
+func (node *Node[T]) SetVal(val T) {
+	node.data = val
+}
+
+// Next returns the pointer to the next Node in the list, nil if this is the
+// last node or the node has been removed from its list
+func (node *Node[T]) Next() *Node[T] {
+	if node.list == nil || node.next == node.list.root {
+		return nil
+	}
+	return node.next
+}
+
+// Prev returns the pointer to the previous Node in the list, nil if this is
+// the first node or the node has been removed from its list
+func (node *Node[T]) Prev() *Node[T] {
+	if node.list == nil || node.prev == node.list.root {
+		return nil
+	}
+	return node.prev
+}
+
+func (node *Node[T]) String() string {
+	return fmt.Sprint(node.data)
+}
@@ -0,0 +1,73 @@
+package expr
+
+import (
+	"fmt"
+
+	sliceStack "github.com/yuhlau/go-data-structures/stack/SliceStack"
+)
+
+// DefaultDelimiters pairs the usual opening delimiters with their closing ones
+var DefaultDelimiters = map[rune]rune{'(': ')', '[': ']', '{': '}'}
+
+// DelimiterError reports an unbalanced delimiter
+type DelimiterError struct {
+	// Pos is the byte offset of the offending delimiter
+	Pos       int
+	Delimiter rune
+	// OpenPos is the byte offset of the opening delimiter left unclosed by a
+	// mismatched closing one, -1 otherwise
+	OpenPos int
+	Message string
+}
+
+func (err *DelimiterError) Error() string {
+	return fmt.Sprintf("%s at position %d", err.Message, err.Pos)
+}
+
+type _open struct {
+	delimiter rune
+	pos       int
+}
+
+// CheckBalanced checks that every opening delimiter of the input is closed by
+// the matching delimiter in pairs, with nested pairs closed first. A pair may
+// use the same rune to open and close, such as quotes, in which case the
+// other delimiters up to the closing one are not checked. Returns a
+// DelimiterError for the first closing delimiter which does not match, or
+// else for the innermost opening delimiter left unclosed
+func CheckBalanced(input string, pairs map[rune]rune) error {
+	closers := make(map[rune]rune, len(pairs))
+	for open, close := range pairs {
+		closers[close] = open
+	}
+
+	opened := sliceStack.New[_open]()
+	for pos, r := range input {
+		top, err := opened.Top()
+		if err == nil && pairs[top.delimiter] == r {
+			opened.Pop()
+			continue
+		}
+		if err == nil && pairs[top.delimiter] == top.delimiter {
+			// Delimiters are literal inside a quote-like pair
+			continue
+		}
+		if _, ok := pairs[r]; ok {
+			opened.Push(_open{r, pos})
+			continue
+		}
+		if _, ok := closers[r]; !ok {
+			continue
+		}
+		if err != nil {
+			return &DelimiterError{pos, r, -1, fmt.Sprintf("Unmatched %q", r)}
+		}
+		return &DelimiterError{pos, r, top.pos, fmt.Sprintf(
+			"Mismatched %q, expected %q", r, pairs[top.delimiter])}
+	}
+
+	if top, err := opened.Top(); err == nil {
+		return &DelimiterError{top.pos, top.delimiter, -1, fmt.Sprintf("Unclosed %q", top.delimiter)}
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package expr
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToRPN(t *testing.T) {
+	assert := assert.New(t)
+
+	grammar := DefaultGrammar()
+	cases := map[string]string{
+		"1 + 2 * 3":          "1 2 3 * +",
+		"(1 + 2) * 3":        "1 2 + 3 *",
+		"1 - 2 - 3":          "1 2 - 3 -",
+		"2 ^ 3 ^ 2":          "2 3 2 ^ ^",
+		"-2 ^ 2":             "2 2 ^ -",
+		"2 ^ -1":             "2 1 - ^",
+		"- -x * y":           "x - - y *",
+		"max(1, 2 + 3, x)":   "1 2 3 + x max",
+		"sqrt(abs(-4)) + .5": "4 - abs sqrt .5 +",
+		"3e2+1":              "3e2 1 +",
+	}
+	for input, expected := range cases {
+		rpn, err := grammar.ToRPN(input)
+		assert.Nil(err, input)
+		assert.Equal(expected, Format(rpn), input)
+	}
+
+	rpn, _ := grammar.ToRPN("max(1, 2, 3) + min()")
+	assert.Equal(3, rpn[3].Args)
+	assert.Equal(0, rpn[4].Args)
+}
+
+func TestToRPNErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	grammar := DefaultGrammar()
+	cases := []struct {
+		input   string
+		pos     int
+		message string
+	}{
+		{"", 0, "Missing operand"},
+		{"1 +", 3, "Missing operand"},
+		{"1 2", 2, "Unexpected operand 2"},
+		{"* 2", 0, "Unexpected character '*'"},
+		{"1 + # 2", 4, "Unexpected character '#'"},
+		{"(1 + 2", 0, "Unmatched ("},
+		{"1 + 2)", 5, "Unmatched )"},
+		{"()", 1, "Empty parentheses"},
+		{"(1 +)", 4, "Missing operand"},
+		{"2 (3)", 2, "Unexpected ("},
+		{"1, 2", 1, "Unexpected ,"},
+		{"max(1,)", 6, "Missing operand"},
+		{"abs(1, 2)", 0, "abs expects 1 argument(s), got 2"},
+		{"1.2.3", 0, "Invalid number 1.2.3"},
+	}
+	for _, c := range cases {
+		_, err := grammar.ToRPN(c.input)
+		var syntaxErr *SyntaxError
+		if assert.True(errors.As(err, &syntaxErr), c.input) {
+			assert.Equal(c.pos, syntaxErr.Pos, c.input)
+			assert.Equal(c.message, syntaxErr.Message, c.input)
+		}
+	}
+}
+
+func TestEval(t *testing.T) {
+	assert := assert.New(t)
+
+	grammar := DefaultGrammar()
+	vars := map[string]float64{"x": 3, "y_2": 4}
+	cases := map[string]float64{
+		"1 + 2 * 3":             7,
+		"(1 + 2) * 3":           9,
+		"10 - 4 - 3":            3,
+		"2 ^ 3 ^ 2":             512,
+		"-2 ^ 2":                -4,
+		"7 % 4":                 3,
+		"sqrt(x * x + y_2 ^ 2)": 5,
+		"max(1, -x, y_2) - +1":  3,
+		"min(x, y_2) / 2":       1.5,
+		"abs(1 - 2 * (3 + x))":  11,
+		"2 * -(1 + 1)":          -4,
+		"sqrt (16)":             4,
+		"1.5e1 + 2.5e-1 * 4":    16,
+	}
+	for input, expected := range cases {
+		val, err := grammar.Eval(input, vars)
+		assert.Nil(err, input)
+		assert.Equal(expected, val, input)
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	grammar := DefaultGrammar()
+	_, err := grammar.Eval("1 + z", nil)
+	assert.Equal("Unknown variable z at position 4", err.Error())
+
+	_, err = grammar.Eval("1 / (2 - 2)", nil)
+	assert.Equal("Division by zero at position 2", err.Error())
+
+	_, err = grammar.Eval("sqrt(-1)", nil)
+	var evalErr *EvalError
+	assert.True(errors.As(err, &evalErr))
+	assert.Equal(0, evalErr.Pos)
+
+	_, err = grammar.EvalRPN([]Token{{Kind: TOKEN_NUMBER, Text: "1"}, {Kind: TOKEN_INFIX, Text: "+", Pos: 2}}, nil)
+	assert.Equal("Missing operand for + at position 2", err.Error())
+	_, err = grammar.EvalRPN([]Token{{Kind: TOKEN_NUMBER, Text: "1"}, {Kind: TOKEN_NUMBER, Text: "2"}}, nil)
+	assert.Equal("Too many operands", err.Error())
+	_, err = grammar.EvalRPN(nil, nil)
+	assert.Equal("Empty expression", err.Error())
+
+	// The number of arguments recorded in a function token must match
+	_, err = grammar.EvalRPN([]Token{{Kind: TOKEN_FUNCTION, Text: "abs", Pos: 3}}, nil)
+	assert.Equal("abs expects 1 argument(s), got 0 at position 3", err.Error())
+	_, err = grammar.EvalRPN([]Token{{Kind: TOKEN_NUMBER, Text: "1"}, {Kind: TOKEN_FUNCTION, Text: "abs", Pos: 2, Args: 2}}, nil)
+	assert.Equal("abs expects 1 argument(s), got 2 at position 2", err.Error())
+	val, err := grammar.EvalRPN([]Token{{Kind: TOKEN_NUMBER, Text: "1"}, {Kind: TOKEN_NUMBER, Text: "2"}, {Kind: TOKEN_FUNCTION, Text: "max", Args: 2}}, nil)
+	assert.Nil(err)
+	assert.Equal(float64(2), val)
+}
+
+func TestCustomGrammar(t *testing.T) {
+	assert := assert.New(t)
+
+	grammar := NewGrammar()
+	boolOf := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	grammar.AddInfix("||", 1, ASSOC_LEFT, func(args []float64) (float64, error) {
+		return boolOf(args[0] != 0 || args[1] != 0), nil
+	})
+	grammar.AddInfix("&&", 2, ASSOC_LEFT, func(args []float64) (float64, error) {
+		return boolOf(args[0] != 0 && args[1] != 0), nil
+	})
+	grammar.AddInfix("<", 3, ASSOC_LEFT, func(args []float64) (float64, error) {
+		return boolOf(args[0] < args[1]), nil
+	})
+	grammar.AddInfix("<=", 3, ASSOC_LEFT, func(args []float64) (float64, error) {
+		return boolOf(args[0] <= args[1]), nil
+	})
+	grammar.AddPrefix("!", 4, func(args []float64) (float64, error) {
+		return boolOf(args[0] == 0), nil
+	})
+	grammar.AddFunction("if", 3, func(args []float64) (float64, error) {
+		if args[0] != 0 {
+			return args[1], nil
+		}
+		return args[2], nil
+	})
+
+	rpn, err := grammar.ToRPN("a <= b && !c || d")
+	assert.Nil(err)
+	assert.Equal("a b <= c ! && d ||", Format(rpn))
+
+	val, err := grammar.Eval("if(a < b, a, b)", map[string]float64{"a": 2, "b": 1})
+	assert.Nil(err)
+	assert.Equal(float64(1), val)
+
+	// Operators may be words, which are not read as variables
+	grammar.AddInfix("mod", 3, ASSOC_LEFT, func(args []float64) (float64, error) {
+		return math.Mod(args[0], args[1]), nil
+	})
+	grammar.AddPrefix("not", 4, func(args []float64) (float64, error) {
+		return boolOf(args[0] == 0), nil
+	})
+	rpn, err = grammar.ToRPN("not a || mod mod 3")
+	assert.Nil(err)
+	assert.Equal("a not mod 3 mod ||", Format(rpn))
+	val, err = grammar.Eval("7 mod 3", nil)
+	assert.Nil(err)
+	assert.Equal(float64(1), val)
+	val, err = grammar.Eval("not modulo", map[string]float64{"modulo": 0})
+	assert.Nil(err)
+	assert.Equal(float64(1), val)
+
+	// Operators of the default grammar are not known
+	_, err = grammar.ToRPN("1 + 1")
+	assert.Equal("Unexpected character '+' at position 2", err.Error())
+
+	// Redefining an operator replaces it
+	grammar.AddInfix("<", 3, ASSOC_LEFT, func(args []float64) (float64, error) {
+		return math.NaN(), nil
+	})
+	val, _ = grammar.Eval("1 < 2", nil)
+	assert.True(math.IsNaN(val))
+}
+
+func TestCheckBalanced(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(CheckBalanced("", DefaultDelimiters))
+	assert.Nil(CheckBalanced("f(a[1], {b: (c)})", DefaultDelimiters))
+
+	cases := []struct {
+		input   string
+		pos     int
+		openPos int
+		message string
+	}{
+		{"(a]", 2, 0, "Mismatched ']', expected ')'"},
+		{"a)", 1, -1, "Unmatched ')'"},
+		{"{[()]", 0, -1, "Unclosed '{'"},
+		{"(()", 0, -1, "Unclosed '('"},
+		{"((", 1, -1, "Unclosed '('"},
+		{"é(]", 3, 2, "Mismatched ']', expected ')'"},
+	}
+	for _, c := range cases {
+		err := CheckBalanced(c.input, DefaultDelimiters)
+		var delimiterErr *DelimiterError
+		if assert.True(errors.As(err, &delimiterErr), c.input) {
+			assert.Equal(c.pos, delimiterErr.Pos, c.input)
+			assert.Equal(c.openPos, delimiterErr.OpenPos, c.input)
+			assert.Equal(c.message, delimiterErr.Message, c.input)
+		}
+	}
+
+	// The same rune may open and close a pair
+	quotes := map[rune]rune{'"': '"', '(': ')'}
+	assert.Nil(CheckBalanced(`("a" "(")`, quotes))
+	assert.Equal("Unclosed '\"' at position 1", CheckBalanced(`("a)`, quotes).Error())
+}
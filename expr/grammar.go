@@ -0,0 +1,164 @@
+// Package expr parses and evaluates infix arithmetic expressions. The
+// operators and functions recognized are defined by a Grammar, so callers can
+// plug in their own
+package expr
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	ASSOC_LEFT = iota
+	ASSOC_RIGHT
+)
+
+// VARIADIC is the arity of a function accepting any number of arguments
+const VARIADIC = -1
+
+// Operator is an infix operator taking two operands, or a prefix operator
+// taking one
+type Operator struct {
+	Symbol string
+	// Precedence orders the operators, higher binds tighter
+	Precedence    int
+	Associativity int
+	// Apply computes the result from the operands, args has one element for a
+	// prefix operator and two for an infix operator
+	Apply func(args []float64) (float64, error)
+}
+
+// Function is a function called as name(arg1, arg2, ...)
+type Function struct {
+	Name string
+	// Arity is the number of arguments, VARIADIC for any number
+	Arity int
+	Apply func(args []float64) (float64, error)
+}
+
+// Grammar holds the operators and functions of the expressions
+type Grammar struct {
+	infix     map[string]Operator
+	prefix    map[string]Operator
+	functions map[string]Function
+}
+
+// NewGrammar creates and returns a Grammar without any operator or function
+func NewGrammar() *Grammar {
+	return &Grammar{
+		infix:     map[string]Operator{},
+		prefix:    map[string]Operator{},
+		functions: map[string]Function{},
+	}
+}
+
+// DefaultGrammar creates and returns a Grammar with the arithmetic operators
+// + - * / % and ^ (right associative), the prefix operators - and +, and the
+// functions abs, sqrt, min and max
+func DefaultGrammar() *Grammar {
+	grammar := NewGrammar()
+	grammar.AddInfix("+", 1, ASSOC_LEFT, func(args []float64) (float64, error) {
+		return args[0] + args[1], nil
+	})
+	grammar.AddInfix("-", 1, ASSOC_LEFT, func(args []float64) (float64, error) {
+		return args[0] - args[1], nil
+	})
+	grammar.AddInfix("*", 2, ASSOC_LEFT, func(args []float64) (float64, error) {
+		return args[0] * args[1], nil
+	})
+	grammar.AddInfix("/", 2, ASSOC_LEFT, func(args []float64) (float64, error) {
+		if args[1] == 0 {
+			return 0, errors.New("Division by zero")
+		}
+		return args[0] / args[1], nil
+	})
+	grammar.AddInfix("%", 2, ASSOC_LEFT, func(args []float64) (float64, error) {
+		if args[1] == 0 {
+			return 0, errors.New("Division by zero")
+		}
+		return math.Mod(args[0], args[1]), nil
+	})
+	grammar.AddInfix("^", 4, ASSOC_RIGHT, func(args []float64) (float64, error) {
+		return math.Pow(args[0], args[1]), nil
+	})
+	// Prefix operators bind tighter than * but looser than ^, so -2^2 is -4
+	grammar.AddPrefix("-", 3, func(args []float64) (float64, error) {
+		return -args[0], nil
+	})
+	grammar.AddPrefix("+", 3, func(args []float64) (float64, error) {
+		return args[0], nil
+	})
+	grammar.AddFunction("abs", 1, func(args []float64) (float64, error) {
+		return math.Abs(args[0]), nil
+	})
+	grammar.AddFunction("sqrt", 1, func(args []float64) (float64, error) {
+		if args[0] < 0 {
+			return 0, errors.New("Square root of a negative number")
+		}
+		return math.Sqrt(args[0]), nil
+	})
+	grammar.AddFunction("min", VARIADIC, func(args []float64) (float64, error) {
+		if len(args) == 0 {
+			return 0, errors.New("min requires at least one argument")
+		}
+		return _extremum(args, func(a, b float64) bool { return a < b }), nil
+	})
+	grammar.AddFunction("max", VARIADIC, func(args []float64) (float64, error) {
+		if len(args) == 0 {
+			return 0, errors.New("max requires at least one argument")
+		}
+		return _extremum(args, func(a, b float64) bool { return a > b }), nil
+	})
+	return grammar
+}
+
+// AddInfix adds or replaces the infix operator with the specified symbol. The
+// symbol is either made of punctuation, like "<=", or a word, like "mod"; a
+// symbol starting with a digit or "." is read as a number and never matches
+func (grammar *Grammar) AddInfix(symbol string, precedence int, associativity int, apply func(args []float64) (float64, error)) {
+	grammar.infix[symbol] = Operator{symbol, precedence, associativity, apply}
+}
+
+// AddPrefix adds or replaces the prefix operator with the specified symbol,
+// which follows the same rules as for AddInfix.
+// A prefix operator applies to everything on its right which binds tighter
+// than its precedence
+func (grammar *Grammar) AddPrefix(symbol string, precedence int, apply func(args []float64) (float64, error)) {
+	grammar.prefix[symbol] = Operator{symbol, precedence, ASSOC_RIGHT, apply}
+}
+
+// AddFunction adds or replaces the function with the specified name
+func (grammar *Grammar) AddFunction(name string, arity int, apply func(args []float64) (float64, error)) {
+	grammar.functions[name] = Function{name, arity, apply}
+}
+
+// Infix returns the infix operator with the specified symbol, false if there
+// is none
+func (grammar *Grammar) Infix(symbol string) (Operator, bool) {
+	op, ok := grammar.infix[symbol]
+	return op, ok
+}
+
+// Prefix returns the prefix operator with the specified symbol, false if there
+// is none
+func (grammar *Grammar) Prefix(symbol string) (Operator, bool) {
+	op, ok := grammar.prefix[symbol]
+	return op, ok
+}
+
+// Function returns the function with the specified name, false if there is
+// none
+func (grammar *Grammar) Function(name string) (Function, bool) {
+	fn, ok := grammar.functions[name]
+	return fn, ok
+}
+
+func _extremum(args []float64, less func(a, b float64) bool) float64 {
+	result := args[0]
+	for _, arg := range args[1:] {
+		if less(arg, result) {
+			result = arg
+		}
+	}
+	return result
+}
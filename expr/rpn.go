@@ -0,0 +1,272 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sliceStack "github.com/yuhlau/go-data-structures/stack/SliceStack"
+)
+
+// EvalError reports an error raised while evaluating a token
+type EvalError struct {
+	// Pos is the byte offset of the token in the expression
+	Pos int
+	Err error
+}
+
+func (err *EvalError) Error() string {
+	return fmt.Sprintf("%v at position %d", err.Err, err.Pos)
+}
+
+func (err *EvalError) Unwrap() error {
+	return err.Err
+}
+
+// _group is a pair of parentheses being parsed, with the function it calls if
+// any and the number of arguments seen so far
+type _group struct {
+	fn   *Token
+	args int
+}
+
+// ToRPN converts the infix expression into Reverse Polish Notation with the
+// shunting-yard algorithm. Parentheses and commas are consumed, and each
+// TOKEN_FUNCTION records its number of arguments in Args. Returns a
+// SyntaxError if the expression is malformed or calls a function with the
+// wrong number of arguments
+func (grammar *Grammar) ToRPN(input string) ([]Token, error) {
+	tokens, err := grammar.Tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]Token, 0, len(tokens))
+	ops := sliceStack.New[Token]()
+	groups := sliceStack.New[*_group]()
+	// popUntilParen moves the operators above the innermost "(" to the output
+	popUntilParen := func() {
+		for top, err := ops.Top(); err == nil && top.Kind != TOKEN_LEFT_PAREN; top, err = ops.Top() {
+			ops.Pop()
+			output = append(output, top)
+		}
+	}
+
+	expectOperand := true
+	var prev *Token
+	for i := range tokens {
+		token := tokens[i]
+		switch token.Kind {
+		case TOKEN_NUMBER, TOKEN_VARIABLE:
+			if !expectOperand {
+				return nil, &SyntaxError{token.Pos, "Unexpected operand " + token.Text}
+			}
+			if token.Kind == TOKEN_NUMBER {
+				if _, err := strconv.ParseFloat(token.Text, 64); err != nil {
+					return nil, &SyntaxError{token.Pos, "Invalid number " + token.Text}
+				}
+			}
+			output = append(output, token)
+			expectOperand = false
+
+		case TOKEN_FUNCTION:
+			if !expectOperand {
+				return nil, &SyntaxError{token.Pos, "Unexpected function " + token.Text}
+			}
+			ops.Push(token)
+
+		case TOKEN_PREFIX:
+			ops.Push(token)
+
+		case TOKEN_INFIX:
+			if expectOperand {
+				return nil, &SyntaxError{token.Pos, "Unexpected operator " + token.Text}
+			}
+			op := grammar.infix[token.Text]
+			for top, err := ops.Top(); err == nil; top, err = ops.Top() {
+				if top.Kind != TOKEN_INFIX && top.Kind != TOKEN_PREFIX {
+					break
+				}
+				topOp := grammar._operator(top)
+				if topOp.Precedence < op.Precedence ||
+					(topOp.Precedence == op.Precedence && op.Associativity == ASSOC_RIGHT) {
+					break
+				}
+				ops.Pop()
+				output = append(output, top)
+			}
+			ops.Push(token)
+			expectOperand = true
+
+		case TOKEN_LEFT_PAREN:
+			if !expectOperand {
+				return nil, &SyntaxError{token.Pos, "Unexpected ("}
+			}
+			group := &_group{}
+			if prev != nil && prev.Kind == TOKEN_FUNCTION {
+				group.fn = prev
+			}
+			ops.Push(token)
+			groups.Push(group)
+
+		case TOKEN_RIGHT_PAREN:
+			group, err := groups.Pop()
+			if err != nil {
+				return nil, &SyntaxError{token.Pos, "Unmatched )"}
+			}
+			empty := prev.Kind == TOKEN_LEFT_PAREN
+			if empty && group.fn == nil {
+				return nil, &SyntaxError{token.Pos, "Empty parentheses"}
+			}
+			if expectOperand && !empty {
+				return nil, &SyntaxError{token.Pos, "Missing operand"}
+			}
+			popUntilParen()
+			ops.Pop()
+			if group.fn != nil {
+				if !empty {
+					group.args++
+				}
+				fn := grammar.functions[group.fn.Text]
+				if fn.Arity != VARIADIC && fn.Arity != group.args {
+					return nil, &SyntaxError{group.fn.Pos, fmt.Sprintf(
+						"%s expects %d argument(s), got %d", fn.Name, fn.Arity, group.args)}
+				}
+				call, _ := ops.Pop()
+				call.Args = group.args
+				output = append(output, call)
+			}
+			expectOperand = false
+
+		case TOKEN_COMMA:
+			group, err := groups.Top()
+			if err != nil || group.fn == nil {
+				return nil, &SyntaxError{token.Pos, "Unexpected ,"}
+			}
+			if expectOperand {
+				return nil, &SyntaxError{token.Pos, "Missing operand"}
+			}
+			popUntilParen()
+			group.args++
+			expectOperand = true
+		}
+		prev = &tokens[i]
+	}
+
+	if expectOperand {
+		return nil, &SyntaxError{len(input), "Missing operand"}
+	}
+	for !ops.IsEmpty() {
+		top, _ := ops.Pop()
+		if top.Kind == TOKEN_LEFT_PAREN {
+			return nil, &SyntaxError{top.Pos, "Unmatched ("}
+		}
+		output = append(output, top)
+	}
+	return output, nil
+}
+
+// EvalRPN evaluates the expression in Reverse Polish Notation, as returned by
+// ToRPN, with the values of the variables. Returns an EvalError if a variable,
+// operator or function is unknown, if an operand is missing or left over, or
+// if an operator or function fails
+func (grammar *Grammar) EvalRPN(rpn []Token, vars map[string]float64) (float64, error) {
+	values := sliceStack.NewWithDefaultCap[float64](uint(len(rpn)))
+	for _, token := range rpn {
+		var arity int
+		var apply func(args []float64) (float64, error)
+		switch token.Kind {
+		case TOKEN_NUMBER:
+			val, err := strconv.ParseFloat(token.Text, 64)
+			if err != nil {
+				return 0, &EvalError{token.Pos, errors.New("Invalid number " + token.Text)}
+			}
+			values.Push(val)
+			continue
+		case TOKEN_VARIABLE:
+			val, ok := vars[token.Text]
+			if !ok {
+				return 0, &EvalError{token.Pos, errors.New("Unknown variable " + token.Text)}
+			}
+			values.Push(val)
+			continue
+		case TOKEN_INFIX, TOKEN_PREFIX:
+			op, ok := grammar._lookupOperator(token)
+			if !ok {
+				return 0, &EvalError{token.Pos, errors.New("Unknown operator " + token.Text)}
+			}
+			arity, apply = 2, op.Apply
+			if token.Kind == TOKEN_PREFIX {
+				arity = 1
+			}
+		case TOKEN_FUNCTION:
+			fn, ok := grammar.functions[token.Text]
+			if !ok {
+				return 0, &EvalError{token.Pos, errors.New("Unknown function " + token.Text)}
+			}
+			if fn.Arity != VARIADIC && token.Args != fn.Arity {
+				return 0, &EvalError{token.Pos, fmt.Errorf(
+					"%s expects %d argument(s), got %d", fn.Name, fn.Arity, token.Args)}
+			}
+			arity, apply = token.Args, fn.Apply
+		default:
+			return 0, &EvalError{token.Pos, errors.New("Unexpected " + token.Text + " in RPN")}
+		}
+
+		if values.Size() < uint(arity) {
+			return 0, &EvalError{token.Pos, errors.New("Missing operand for " + token.Text)}
+		}
+		args := make([]float64, arity)
+		for i := arity - 1; i >= 0; i-- {
+			args[i], _ = values.Pop()
+		}
+		result, err := apply(args)
+		if err != nil {
+			return 0, &EvalError{token.Pos, err}
+		}
+		values.Push(result)
+	}
+
+	result, err := values.Pop()
+	if err != nil {
+		return 0, errors.New("Empty expression")
+	}
+	if !values.IsEmpty() {
+		return 0, errors.New("Too many operands")
+	}
+	return result, nil
+}
+
+// Eval converts the infix expression with ToRPN and evaluates it with EvalRPN
+func (grammar *Grammar) Eval(input string, vars map[string]float64) (float64, error) {
+	rpn, err := grammar.ToRPN(input)
+	if err != nil {
+		return 0, err
+	}
+	return grammar.EvalRPN(rpn, vars)
+}
+
+// Format returns the tokens separated by spaces, e.g. "1 2 3 * +"
+func Format(tokens []Token) string {
+	texts := make([]string, len(tokens))
+	for i, token := range tokens {
+		texts[i] = token.Text
+	}
+	return strings.Join(texts, " ")
+}
+
+// _lookupOperator returns the infix or prefix operator of the token
+func (grammar *Grammar) _lookupOperator(token Token) (Operator, bool) {
+	if token.Kind == TOKEN_PREFIX {
+		return grammar.Prefix(token.Text)
+	}
+	return grammar.Infix(token.Text)
+}
+
+// _operator returns the operator of a token produced by Tokenize, which is
+// always known to the grammar
+func (grammar *Grammar) _operator(token Token) Operator {
+	op, _ := grammar._lookupOperator(token)
+	return op
+}
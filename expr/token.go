@@ -0,0 +1,187 @@
+package expr
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	TOKEN_NUMBER = iota
+	TOKEN_VARIABLE
+	TOKEN_INFIX
+	TOKEN_PREFIX
+	TOKEN_FUNCTION
+	TOKEN_LEFT_PAREN
+	TOKEN_RIGHT_PAREN
+	TOKEN_COMMA
+)
+
+// Token is a lexical unit of an expression
+type Token struct {
+	Kind int
+	Text string
+	// Pos is the byte offset of the token in the expression
+	Pos int
+	// Args is the number of arguments of a TOKEN_FUNCTION in RPN, as a
+	// variadic function needs it to be evaluated
+	Args int
+}
+
+func (token Token) String() string {
+	return token.Text
+}
+
+// SyntaxError reports an invalid expression and where it was detected
+type SyntaxError struct {
+	// Pos is the byte offset in the expression, its length when the error is
+	// at the end of the expression
+	Pos     int
+	Message string
+}
+
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", err.Message, err.Pos)
+}
+
+// Tokenize splits the expression into tokens. Identifiers which are the
+// symbol of an operator, such as "mod", are operators, identifiers followed
+// by "(" are functions, and other identifiers are variables. Where an operand
+// is expected, operator symbols are read as prefix operators, otherwise as
+// infix operators; in both cases the longest symbol of the grammar is
+// preferred. Returns a SyntaxError on a character which cannot start any token
+func (grammar *Grammar) Tokenize(input string) ([]Token, error) {
+	tokens := []Token{}
+	expectOperand := true
+	for pos := 0; pos < len(input); {
+		r, width := utf8.DecodeRuneInString(input[pos:])
+		switch {
+		case unicode.IsSpace(r):
+			pos += width
+			continue
+		case r == '(':
+			tokens = append(tokens, Token{Kind: TOKEN_LEFT_PAREN, Text: "(", Pos: pos})
+			pos++
+			expectOperand = true
+			continue
+		case r == ')':
+			tokens = append(tokens, Token{Kind: TOKEN_RIGHT_PAREN, Text: ")", Pos: pos})
+			pos++
+			expectOperand = false
+			continue
+		case r == ',':
+			tokens = append(tokens, Token{Kind: TOKEN_COMMA, Text: ",", Pos: pos})
+			pos++
+			expectOperand = true
+			continue
+		case r == '.' || _isDigit(r):
+			end := _scanNumber(input, pos)
+			tokens = append(tokens, Token{Kind: TOKEN_NUMBER, Text: input[pos:end], Pos: pos})
+			pos = end
+			expectOperand = false
+			continue
+		case r == '_' || unicode.IsLetter(r):
+			end := _scanIdentifier(input, pos)
+			if kind, ok := grammar._wordOperator(input[pos:end], expectOperand); ok {
+				tokens = append(tokens, Token{Kind: kind, Text: input[pos:end], Pos: pos})
+				pos = end
+				expectOperand = true
+				continue
+			}
+			kind := TOKEN_VARIABLE
+			if _, ok := grammar.functions[input[pos:end]]; ok && _nextNonSpace(input, end) == '(' {
+				kind = TOKEN_FUNCTION
+			}
+			tokens = append(tokens, Token{Kind: kind, Text: input[pos:end], Pos: pos})
+			pos = end
+			expectOperand = kind == TOKEN_FUNCTION
+			continue
+		}
+
+		operators, kind := grammar.infix, TOKEN_INFIX
+		if expectOperand {
+			operators, kind = grammar.prefix, TOKEN_PREFIX
+		}
+		symbol := _longestSymbol(operators, input[pos:])
+		if symbol == "" {
+			return nil, &SyntaxError{pos, fmt.Sprintf("Unexpected character %q", r)}
+		}
+		tokens = append(tokens, Token{Kind: kind, Text: symbol, Pos: pos})
+		pos += len(symbol)
+		expectOperand = true
+	}
+	return tokens, nil
+}
+
+// _wordOperator returns the kind of the operator whose symbol is the word,
+// prefix where an operand is expected and infix otherwise, false if there is
+// none
+func (grammar *Grammar) _wordOperator(word string, expectOperand bool) (int, bool) {
+	if expectOperand {
+		_, ok := grammar.prefix[word]
+		return TOKEN_PREFIX, ok
+	}
+	_, ok := grammar.infix[word]
+	return TOKEN_INFIX, ok
+}
+
+// _scanNumber returns the end of the number starting at pos: digits with an
+// optional fraction and exponent
+func _scanNumber(input string, pos int) int {
+	end := pos
+	for end < len(input) && (input[end] == '.' || _isDigit(rune(input[end]))) {
+		end++
+	}
+	if end < len(input) && (input[end] == 'e' || input[end] == 'E') {
+		exp := end + 1
+		if exp < len(input) && (input[exp] == '+' || input[exp] == '-') {
+			exp++
+		}
+		if exp < len(input) && _isDigit(rune(input[exp])) {
+			for end = exp; end < len(input) && _isDigit(rune(input[end])); end++ {
+			}
+		}
+	}
+	return end
+}
+
+// _scanIdentifier returns the end of the identifier starting at pos
+func _scanIdentifier(input string, pos int) int {
+	end := pos
+	for end < len(input) {
+		r, width := utf8.DecodeRuneInString(input[end:])
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		end += width
+	}
+	return end
+}
+
+// _nextNonSpace returns the first rune from pos which is not a space, 0 if
+// there is none
+func _nextNonSpace(input string, pos int) rune {
+	for _, r := range input[pos:] {
+		if !unicode.IsSpace(r) {
+			return r
+		}
+	}
+	return 0
+}
+
+// _longestSymbol returns the longest symbol of the operators which input
+// starts with, empty if there is none
+func _longestSymbol(operators map[string]Operator, input string) string {
+	longest := ""
+	for symbol := range operators {
+		if len(symbol) > len(longest) && strings.HasPrefix(input, symbol) {
+			longest = symbol
+		}
+	}
+	return longest
+}
+
+func _isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
@@ -0,0 +1,251 @@
+// Package history keeps the undo and redo history of an editor as commands
+// which know how to apply and revert themselves
+package history
+
+import (
+	"errors"
+
+	"github.com/yuhlau/go-data-structures/doublyLinkedList"
+	sliceStack "github.com/yuhlau/go-data-structures/stack/SliceStack"
+)
+
+// Command is a change which can be applied and reverted
+type Command interface {
+	// Do applies the change
+	Do() error
+	// Undo reverts the change applied by Do
+	Undo() error
+}
+
+// Savepoint identifies a state of the history, see History.Savepoint
+type Savepoint uint
+
+// History records the commands executed so that they can be undone and
+// redone. The undo history is a stack whose bottom is evicted once it grows
+// past the limit, so it is kept in a DoublyLinkedList; the redo history is a
+// plain SliceStack
+type History struct {
+	undo  *doublyLinkedList.DoublyLinkedList[entry]
+	redo  *sliceStack.Stack[entry]
+	limit uint
+	// lastID is the id given to the last entry created, and evicted is the id
+	// of the last entry evicted from the bottom of the undo history, which
+	// identifies the state once everything left has been undone
+	lastID  Savepoint
+	evicted Savepoint
+	saved   Savepoint
+	// transaction gathers the commands executed since Begin, depth counts
+	// the nested calls to Begin
+	transaction *Transaction
+	depth       uint
+}
+
+type entry struct {
+	cmd Command
+	id  Savepoint
+}
+
+// New creates and returns an empty History keeping at most limit commands to
+// undo, 0 for no limit
+func New(limit uint) *History {
+	return &History{
+		undo:  doublyLinkedList.New[entry](),
+		redo:  sliceStack.New[entry](),
+		limit: limit,
+	}
+}
+
+// Execute runs the command and records it, which drops the commands which
+// could be redone. Within a transaction the command is recorded as part of
+// the transaction. Returns the error of the command, in which case nothing is
+// recorded
+func (history *History) Execute(cmd Command) error {
+	if err := cmd.Do(); err != nil {
+		return err
+	}
+	if history.transaction != nil {
+		history.transaction.Commands = append(history.transaction.Commands, cmd)
+		return nil
+	}
+	history._record(cmd)
+	return nil
+}
+
+// Undo reverts the last command executed, error if there is nothing to undo,
+// a transaction is in progress, or the command fails to undo
+func (history *History) Undo() error {
+	if history.transaction != nil {
+		return errors.New("Transaction in progress")
+	}
+	last := history.undo.Back()
+	if last == nil {
+		return errors.New("Nothing to undo")
+	}
+	if err := last.Val().cmd.Undo(); err != nil {
+		return err
+	}
+	history.undo.Remove(last)
+	history.redo.Push(last.Val())
+	return nil
+}
+
+// Redo applies again the last command undone, error if there is nothing to
+// redo, a transaction is in progress, or the command fails
+func (history *History) Redo() error {
+	if history.transaction != nil {
+		return errors.New("Transaction in progress")
+	}
+	next, err := history.redo.Top()
+	if err != nil {
+		return errors.New("Nothing to redo")
+	}
+	if err := next.cmd.Do(); err != nil {
+		return err
+	}
+	history.redo.Pop()
+	history._push(next)
+	return nil
+}
+
+// CanUndo returns whether there is a command to undo
+func (history *History) CanUndo() bool {
+	return history.transaction == nil && !history.undo.IsEmpty()
+}
+
+// CanRedo returns whether there is a command to redo
+func (history *History) CanRedo() bool {
+	return history.transaction == nil && !history.redo.IsEmpty()
+}
+
+// UndoSize returns the number of commands which can be undone
+func (history *History) UndoSize() uint {
+	return history.undo.Size()
+}
+
+// RedoSize returns the number of commands which can be redone
+func (history *History) RedoSize() uint {
+	return history.redo.Size()
+}
+
+// Begin starts a transaction. The commands executed until the matching
+// Commit are undone and redone as a single command. Transactions may be
+// nested, only the outermost one is recorded
+func (history *History) Begin() {
+	if history.depth == 0 {
+		history.transaction = &Transaction{}
+	}
+	history.depth++
+}
+
+// Commit ends the innermost transaction and, for the outermost one, records
+// its commands as a single command unless there is none. Returns error if no
+// transaction is in progress
+func (history *History) Commit() error {
+	if history.depth == 0 {
+		return errors.New("No transaction in progress")
+	}
+	history.depth--
+	if history.depth > 0 {
+		return nil
+	}
+	transaction := history.transaction
+	history.transaction = nil
+	if len(transaction.Commands) > 0 {
+		history._record(transaction)
+	}
+	return nil
+}
+
+// Rollback undoes every command executed in the transaction, nested ones
+// included, and ends it without recording anything. Returns error if no
+// transaction is in progress, or the error of the first command failing to
+// undo, in which case the transaction stays in progress with the commands
+// still applied
+func (history *History) Rollback() error {
+	if history.depth == 0 {
+		return errors.New("No transaction in progress")
+	}
+	if err := history.transaction.Undo(); err != nil {
+		return err
+	}
+	history.transaction = nil
+	history.depth = 0
+	return nil
+}
+
+// Savepoint returns an identifier of the current state, which can later be
+// compared with IsAt. Undoing or redoing back to the same state gives the same
+// identifier
+func (history *History) Savepoint() Savepoint {
+	if last := history.undo.Back(); last != nil {
+		return last.Val().id
+	}
+	return history.evicted
+}
+
+// IsAt returns whether the current state is the one of the savepoint
+func (history *History) IsAt(savepoint Savepoint) bool {
+	return history.transaction == nil && history.Savepoint() == savepoint
+}
+
+// MarkSaved records the current state as the saved one
+func (history *History) MarkSaved() {
+	history.saved = history.Savepoint()
+}
+
+// IsDirty returns whether the current state differs from the one recorded by
+// the last call to MarkSaved, or from the initial state if there was none
+func (history *History) IsDirty() bool {
+	return !history.IsAt(history.saved)
+}
+
+// _record pushes a new entry for the command and drops the redo history
+func (history *History) _record(cmd Command) {
+	history.lastID++
+	history._push(entry{cmd, history.lastID})
+	history.redo = sliceStack.New[entry]()
+}
+
+// _push pushes the entry to the undo history and evicts the oldest entries
+// past the limit
+func (history *History) _push(e entry) {
+	history.undo.PushBack(e)
+	for history.limit > 0 && history.undo.Size() > history.limit {
+		oldest, _ := history.undo.PopFront()
+		history.evicted = oldest.id
+	}
+}
+
+// Transaction is a Command made of several commands, applied in order and
+// reverted in reverse order
+type Transaction struct {
+	Commands []Command
+}
+
+// Do applies the commands in order. If one fails, those already applied are
+// reverted and the error is returned
+func (transaction *Transaction) Do() error {
+	for i, cmd := range transaction.Commands {
+		if err := cmd.Do(); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				transaction.Commands[j].Undo()
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Undo reverts the commands in reverse order. If one fails, those already
+// reverted are applied again and the error is returned
+func (transaction *Transaction) Undo() error {
+	for i := len(transaction.Commands) - 1; i >= 0; i-- {
+		if err := transaction.Commands[i].Undo(); err != nil {
+			for j := i + 1; j < len(transaction.Commands); j++ {
+				transaction.Commands[j].Do()
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package history
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// document is edited by appendCmd, which appends a word and removes it on undo
+type document struct {
+	words []string
+}
+
+type appendCmd struct {
+	doc  *document
+	word string
+	fail bool
+}
+
+func (cmd *appendCmd) Do() error {
+	if cmd.fail {
+		return errors.New("Cannot append")
+	}
+	cmd.doc.words = append(cmd.doc.words, cmd.word)
+	return nil
+}
+
+func (cmd *appendCmd) Undo() error {
+	cmd.doc.words = cmd.doc.words[:len(cmd.doc.words)-1]
+	return nil
+}
+
+func TestUndoRedo(t *testing.T) {
+	assert := assert.New(t)
+
+	doc := &document{}
+	h := New(0)
+	assert.Equal("Nothing to undo", h.Undo().Error())
+	assert.Equal("Nothing to redo", h.Redo().Error())
+
+	for _, word := range []string{"a", "b", "c"} {
+		assert.Nil(h.Execute(&appendCmd{doc: doc, word: word}))
+	}
+	assert.Equal([]string{"a", "b", "c"}, doc.words)
+
+	assert.Nil(h.Undo())
+	assert.Nil(h.Undo())
+	assert.Equal([]string{"a"}, doc.words)
+	assert.True(h.CanUndo())
+	assert.True(h.CanRedo())
+	assert.Equal(uint(1), h.UndoSize())
+	assert.Equal(uint(2), h.RedoSize())
+
+	assert.Nil(h.Redo())
+	assert.Equal([]string{"a", "b"}, doc.words)
+
+	// A new command drops what could be redone
+	assert.Nil(h.Execute(&appendCmd{doc: doc, word: "d"}))
+	assert.Equal([]string{"a", "b", "d"}, doc.words)
+	assert.False(h.CanRedo())
+	assert.Equal("Nothing to redo", h.Redo().Error())
+}
+
+func TestExecuteFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	doc := &document{}
+	h := New(0)
+	h.Execute(&appendCmd{doc: doc, word: "a"})
+	h.Undo()
+
+	err := h.Execute(&appendCmd{doc: doc, fail: true})
+	assert.Equal("Cannot append", err.Error())
+	assert.False(h.CanUndo())
+	// Nothing was recorded so the redo history is kept
+	assert.True(h.CanRedo())
+}
+
+func TestTransaction(t *testing.T) {
+	assert := assert.New(t)
+
+	doc := &document{}
+	h := New(0)
+	h.Execute(&appendCmd{doc: doc, word: "a"})
+
+	h.Begin()
+	h.Execute(&appendCmd{doc: doc, word: "b"})
+	h.Begin()
+	h.Execute(&appendCmd{doc: doc, word: "c"})
+	assert.Nil(h.Commit())
+	assert.False(h.CanUndo())
+	assert.Equal("Transaction in progress", h.Undo().Error())
+	h.Execute(&appendCmd{doc: doc, word: "d"})
+	assert.Nil(h.Commit())
+	assert.Equal("No transaction in progress", h.Commit().Error())
+
+	assert.Equal(uint(2), h.UndoSize())
+	assert.Nil(h.Undo())
+	assert.Equal([]string{"a"}, doc.words)
+	assert.Nil(h.Redo())
+	assert.Equal([]string{"a", "b", "c", "d"}, doc.words)
+
+	// An empty transaction records nothing
+	h.Begin()
+	h.Commit()
+	assert.Equal(uint(2), h.UndoSize())
+}
+
+func TestRollback(t *testing.T) {
+	assert := assert.New(t)
+
+	doc := &document{}
+	h := New(0)
+	assert.Equal("No transaction in progress", h.Rollback().Error())
+
+	h.Execute(&appendCmd{doc: doc, word: "a"})
+	h.Begin()
+	h.Execute(&appendCmd{doc: doc, word: "b"})
+	h.Begin()
+	h.Execute(&appendCmd{doc: doc, word: "c"})
+	assert.Nil(h.Rollback())
+
+	assert.Equal([]string{"a"}, doc.words)
+	assert.Equal(uint(1), h.UndoSize())
+	assert.Equal("No transaction in progress", h.Commit().Error())
+}
+
+func TestTransactionDoFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	doc := &document{}
+	failing := &appendCmd{doc: doc, word: "c"}
+	transaction := &Transaction{Commands: []Command{
+		&appendCmd{doc: doc, word: "a"},
+		&appendCmd{doc: doc, word: "b"},
+		failing,
+	}}
+	failing.fail = true
+	assert.Equal("Cannot append", transaction.Do().Error())
+	assert.Empty(doc.words)
+}
+
+func TestLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	doc := &document{}
+	h := New(2)
+	for _, word := range []string{"a", "b", "c", "d"} {
+		h.Execute(&appendCmd{doc: doc, word: word})
+	}
+	assert.Equal(uint(2), h.UndoSize())
+
+	assert.Nil(h.Undo())
+	assert.Nil(h.Undo())
+	assert.Equal("Nothing to undo", h.Undo().Error())
+	assert.Equal([]string{"a", "b"}, doc.words)
+
+	// Redoing does not exceed the limit either
+	h.Redo()
+	h.Redo()
+	assert.Equal(uint(2), h.UndoSize())
+	assert.Equal([]string{"a", "b", "c", "d"}, doc.words)
+}
+
+func TestDirty(t *testing.T) {
+	assert := assert.New(t)
+
+	doc := &document{}
+	h := New(0)
+	assert.False(h.IsDirty())
+
+	h.Execute(&appendCmd{doc: doc, word: "a"})
+	assert.True(h.IsDirty())
+	h.Undo()
+	assert.False(h.IsDirty())
+	h.Redo()
+	assert.True(h.IsDirty())
+
+	h.MarkSaved()
+	saved := h.Savepoint()
+	assert.False(h.IsDirty())
+	h.Execute(&appendCmd{doc: doc, word: "b"})
+	assert.True(h.IsDirty())
+	h.Undo()
+	assert.False(h.IsDirty())
+	assert.True(h.IsAt(saved))
+
+	// The saved state cannot be reached again once its command is replaced
+	h.Undo()
+	h.Execute(&appendCmd{doc: doc, word: "c"})
+	assert.True(h.IsDirty())
+	h.Undo()
+	assert.True(h.IsDirty())
+
+	// A transaction in progress is always dirty
+	h.Redo()
+	h.MarkSaved()
+	h.Begin()
+	assert.True(h.IsDirty())
+	h.Rollback()
+	assert.False(h.IsDirty())
+}
+
+func TestDirtyWithEviction(t *testing.T) {
+	assert := assert.New(t)
+
+	doc := &document{}
+	h := New(1)
+	h.MarkSaved()
+	h.Execute(&appendCmd{doc: doc, word: "a"})
+	h.Execute(&appendCmd{doc: doc, word: "b"})
+
+	// Undoing everything left goes back to "a", not to the saved empty document
+	h.Undo()
+	assert.Equal([]string{"a"}, doc.words)
+	assert.True(h.IsDirty())
+}
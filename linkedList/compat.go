@@ -0,0 +1,23 @@
+package linkedList
+
+// LinkedList is the List holding interface{} elements
+type LinkedList = List[interface{}]
+
+// LinkedNode is the Node holding an interface{} value, see LinkedList
+type LinkedNode = Node[interface{}]
+
+// NewLinkedList creates and returns an empty LinkedList
+func NewLinkedList() *LinkedList {
+	return New[interface{}]()
+}
+
+// NewLinkedNode creates and return a new empty LinkedNode
+func NewLinkedNode() *LinkedNode {
+	return NewNode[interface{}]()
+}
+
+// NewLinkedNodeWithVal creates and return the pointer to the created
+// LinkedNode with the provided data
+func NewLinkedNodeWithVal(data interface{}) *LinkedNode {
+	return NewNodeWithVal[interface{}](data)
+}
@@ -0,0 +1,136 @@
+package linkedList
+
+// Cycle describes the loop at the end of a chain of nodes linked back to one
+// of their predecessors with Node.SetNext
+type Cycle[T any] struct {
+	// Start is the first node of the chain which is part of the loop, Pos its
+	// position counted from the first node of the chain
+	Start *Node[T]
+	Pos   uint
+	// Length is the number of nodes in the loop
+	Length uint
+	// Last is the node of the loop whose next pointer links back to Start
+	Last *Node[T]
+}
+
+// Break unlinks Last from Start, which turns the chain back into one that
+// ends. The List the nodes belong to, if any, recounts its nodes on next use
+func (cycle *Cycle[T]) Break() {
+	cycle.Last.SetNext(nil)
+}
+
+// FloydCycle looks for a loop in the chain of nodes starting from first with
+// Floyd's tortoise and hare algorithm, and returns it, nil if the chain ends.
+// Runs in O(n) time and O(1) memory
+func FloydCycle[T any](first *Node[T]) *Cycle[T] {
+	// The hare moves two nodes at a time and can only meet the tortoise again
+	// if the chain loops
+	slow, fast := first, first
+	for fast != nil && fast.next != nil {
+		slow, fast = slow.next, fast.next.next
+		if slow == fast {
+			// Moving from the first node and from the meeting point at the
+			// same pace, the two pointers meet at the start of the loop
+			start, pos := first, uint(0)
+			for current := slow; start != current; current = current.next {
+				start = start.next
+				pos++
+			}
+			return describeCycle(start, pos)
+		}
+	}
+	return nil
+}
+
+// BrentCycle looks for a loop in the chain of nodes starting from first with
+// Brent's algorithm, and returns it, nil if the chain ends. It finds the same
+// loop as FloydCycle in O(n) time and O(1) memory, but follows fewer next
+// pointers
+func BrentCycle[T any](first *Node[T]) *Cycle[T] {
+	if first == nil {
+		return nil
+	}
+	// The hare moves one node at a time and the tortoise teleports to it
+	// every power of two steps, so that the hare meets it once the tortoise
+	// is in the loop and the power exceeds the length of the loop
+	power, length := uint(1), uint(1)
+	tortoise, hare := first, first.next
+	for tortoise != hare {
+		if hare == nil {
+			return nil
+		}
+		if power == length {
+			tortoise = hare
+			power *= 2
+			length = 0
+		}
+		hare = hare.next
+		length++
+	}
+
+	// With the hare length nodes ahead of the tortoise, the two meet at the
+	// start of the loop
+	tortoise, hare = first, first
+	for i := uint(0); i < length; i++ {
+		hare = hare.next
+	}
+	pos := uint(0)
+	for tortoise != hare {
+		tortoise, hare = tortoise.next, hare.next
+		pos++
+	}
+	return describeCycle(tortoise, pos)
+}
+
+// FindCycle looks for a loop in the nodes of the list with BrentCycle and
+// returns it, nil if the list is not looping. Positions are counted from the
+// first element
+func (list *List[T]) FindCycle() *Cycle[T] {
+	return BrentCycle(list.head.next)
+}
+
+// BreakCycle unlinks the node closing the loop of the list if there is one,
+// and returns the Cycle which was broken, nil if the list was not looping.
+// The elements of the loop stay in the list, in order from Start to Last
+func (list *List[T]) BreakCycle() *Cycle[T] {
+	cycle := list.FindCycle()
+	if cycle == nil {
+		return nil
+	}
+	// Last may have been linked in by hand without being part of the list,
+	// the list is recounted either way
+	cycle.Last.next = nil
+	list.dirty = true
+	list.debugValidate()
+	return cycle
+}
+
+// SafeSize returns the number of element(s) in the list like Size, or a
+// *ValidationError for INVARIANT_ACYCLIC instead of looping forever if the
+// nodes loop. The nodes are checked first, which takes O(n)
+func (list *List[T]) SafeSize() (uint, error) {
+	if cycle := list.FindCycle(); cycle != nil {
+		return 0, list.cycleError(cycle)
+	}
+	return list.Size(), nil
+}
+
+// SafeString returns the elements of the list like String, or a
+// *ValidationError for INVARIANT_ACYCLIC instead of looping forever if the
+// nodes loop
+func (list *List[T]) SafeString() (string, error) {
+	if cycle := list.FindCycle(); cycle != nil {
+		return "", list.cycleError(cycle)
+	}
+	return list.String(), nil
+}
+
+// describeCycle returns the Cycle starting from the node at the position
+func describeCycle[T any](start *Node[T], pos uint) *Cycle[T] {
+	last, length := start, uint(1)
+	for last.next != start {
+		last = last.next
+		length++
+	}
+	return &Cycle[T]{Start: start, Pos: pos, Length: length, Last: last}
+}
@@ -0,0 +1,120 @@
+package linkedList
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newLoopingChain links tail + loop nodes holding their position, the last
+// one linking back to the node at position tail. A loop of 0 ends the chain
+func newLoopingChain(tail, loop int) []*Node[int] {
+	nodes := make([]*Node[int], tail+loop)
+	for i := range nodes {
+		nodes[i] = NewNodeWithVal(i)
+		if i > 0 {
+			nodes[i-1].SetNext(nodes[i])
+		}
+	}
+	if loop > 0 {
+		nodes[len(nodes)-1].SetNext(nodes[tail])
+	}
+	return nodes
+}
+
+func TestFloydAndBrentCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(FloydCycle[int](nil))
+	assert.Nil(BrentCycle[int](nil))
+
+	for tail := 0; tail <= 9; tail++ {
+		for loop := 0; loop <= 17; loop++ {
+			if tail+loop == 0 {
+				continue
+			}
+			nodes := newLoopingChain(tail, loop)
+			for name, find := range map[string]func(*Node[int]) *Cycle[int]{
+				"Floyd": FloydCycle[int],
+				"Brent": BrentCycle[int],
+			} {
+				cycle := find(nodes[0])
+				if loop == 0 {
+					assert.Nil(cycle, "%s tail %d", name, tail)
+					continue
+				}
+				if assert.NotNil(cycle, "%s tail %d loop %d", name, tail, loop) {
+					assert.Equal(nodes[tail], cycle.Start, "%s tail %d loop %d", name, tail, loop)
+					assert.Equal(uint(tail), cycle.Pos, "%s tail %d loop %d", name, tail, loop)
+					assert.Equal(uint(loop), cycle.Length, "%s tail %d loop %d", name, tail, loop)
+					assert.Equal(nodes[len(nodes)-1], cycle.Last, "%s tail %d loop %d", name, tail, loop)
+				}
+			}
+		}
+	}
+}
+
+func TestCycleBreak(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes := newLoopingChain(2, 3)
+	cycle := BrentCycle(nodes[0])
+	cycle.Break()
+	assert.Nil(BrentCycle(nodes[0]))
+	assert.Nil(nodes[4].Next())
+}
+
+func TestListCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newIntList(0, 1, 2, 3, 4)
+	assert.Nil(list.FindCycle())
+	assert.Nil(list.BreakCycle())
+	size, err := list.SafeSize()
+	assert.Nil(err)
+	assert.Equal(uint(5), size)
+	str, err := list.SafeString()
+	assert.Nil(err)
+	assert.Equal("[0 1 2 3 4]", str)
+
+	second, _ := list.GetNode(1)
+	last, _ := list.GetNode(4)
+	last.SetNext(second)
+
+	cycle := list.FindCycle()
+	assert.Equal(second, cycle.Start)
+	assert.Equal(uint(1), cycle.Pos)
+	assert.Equal(uint(4), cycle.Length)
+	assert.Equal(last, cycle.Last)
+
+	_, err = list.SafeSize()
+	assertViolation(assert, err, last, 4, INVARIANT_ACYCLIC)
+	_, err = list.SafeString()
+	assertViolation(assert, err, last, 4, INVARIANT_ACYCLIC)
+	assertViolation(assert, list.Validate(), last, 4, INVARIANT_ACYCLIC)
+
+	broken := list.BreakCycle()
+	assert.Equal(last, broken.Last)
+	assert.Nil(list.FindCycle())
+	assertIntList(assert, list, 0, 1, 2, 3, 4)
+}
+
+func TestListBreakCycleOfForeignNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	// The loop is made of nodes linked in by hand which the list has never
+	// counted
+	list := newIntList(0)
+	first, _ := list.GetNode(0)
+	nodes := newLoopingChain(1, 2)
+	first.SetNext(nodes[0])
+
+	cycle := list.BreakCycle()
+	assert.Equal(uint(2), cycle.Pos)
+	assert.Equal(uint(2), cycle.Length)
+	assertIntList(assert, list, 0, 0, 1, 2)
+
+	// The nodes are part of the list once recounted
+	nodes[2].InsertAfter(3)
+	assertIntList(assert, list, 0, 0, 1, 2, 3)
+}
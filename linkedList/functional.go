@@ -0,0 +1,269 @@
+package linkedList
+
+// The combinators below come in two versions: the plain one leaves the list
+// untouched and returns a new List, the InPlace one changes the list itself,
+// relinking its nodes rather than allocating new ones. Nodes which are
+// removed from the list by an InPlace version are detached from it, and nodes
+// moved to another List belong to that List afterwards.
+//
+// Map to another element type, Fold, GroupBy and Zip need type parameters of
+// their own and are therefore functions rather than methods
+
+// Pair holds two values, one from each list given to Zip
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+// Map returns a new List holding the result of fn for every element of the
+// list, in the same order
+func Map[T, U any](list *List[T], fn func(T) U) *List[U] {
+	mapped := New[U]()
+	for val := range list.Values() {
+		mapped.Append(fn(val))
+	}
+	return mapped
+}
+
+// MapInPlace replaces every element of the list with the result of fn
+func (list *List[T]) MapInPlace(fn func(T) T) {
+	for current := list.head.next; current != nil; current = current.next {
+		current.data = fn(current.data)
+	}
+}
+
+// Filter returns a new List holding the elements satisfying pred, in the same
+// order
+func (list *List[T]) Filter(pred func(T) bool) *List[T] {
+	filtered := New[T]()
+	for val := range list.Values() {
+		if pred(val) {
+			filtered.Append(val)
+		}
+	}
+	return filtered
+}
+
+// FilterInPlace removes the elements not satisfying pred from the list
+func (list *List[T]) FilterInPlace(pred func(T) bool) {
+	list.unlinkIf(func(val T) bool { return !pred(val) }, nil)
+}
+
+// Reduce combines the elements from the first to the last with fn, starting
+// with the first element, second returned value will be false if the list is
+// empty
+func (list *List[T]) Reduce(fn func(acc, val T) T) (T, bool) {
+	acc, ok := list.Head()
+	if !ok {
+		return acc, false
+	}
+	for current := list.head.next.next; current != nil; current = current.next {
+		acc = fn(acc, current.data)
+	}
+	return acc, true
+}
+
+// Fold combines the elements from the first to the last with fn, starting
+// with init, and returns init if the list is empty
+func Fold[T, A any](list *List[T], init A, fn func(acc A, val T) A) A {
+	acc := init
+	for val := range list.Values() {
+		acc = fn(acc, val)
+	}
+	return acc
+}
+
+// Any returns whether at least one element satisfies pred
+func (list *List[T]) Any(pred func(T) bool) bool {
+	return list.Find(pred) != -1
+}
+
+// Every returns whether all the elements satisfy pred, true if the list is
+// empty. It is not named All as All iterates over the list
+func (list *List[T]) Every(pred func(T) bool) bool {
+	return list.Find(func(val T) bool { return !pred(val) }) == -1
+}
+
+// FindAll returns the index of every element satisfying pred, in increasing
+// order
+func (list *List[T]) FindAll(pred func(T) bool) []int {
+	indexes := []int{}
+	for i, val := range list.All() {
+		if pred(val) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// Partition returns a new List holding the elements satisfying pred and
+// another holding the rest, both in the same order as in the list
+func (list *List[T]) Partition(pred func(T) bool) (*List[T], *List[T]) {
+	matching, rest := New[T](), New[T]()
+	for val := range list.Values() {
+		if pred(val) {
+			matching.Append(val)
+		} else {
+			rest.Append(val)
+		}
+	}
+	return matching, rest
+}
+
+// PartitionInPlace keeps in the list the elements satisfying pred and moves
+// the nodes of the rest, in order, to the returned List
+func (list *List[T]) PartitionInPlace(pred func(T) bool) *List[T] {
+	rest := New[T]()
+	list.unlinkIf(func(val T) bool { return !pred(val) }, func(T) *List[T] { return rest })
+	return rest
+}
+
+// GroupBy returns a new List for every key returned by key, holding the
+// elements of the list with that key in the same order
+func GroupBy[T any, K comparable](list *List[T], key func(T) K) map[K]*List[T] {
+	groups := map[K]*List[T]{}
+	for val := range list.Values() {
+		groupOf(groups, key(val)).Append(val)
+	}
+	return groups
+}
+
+// GroupByInPlace moves every node of the list, in order, to the List of its
+// key as returned by key, leaving the list empty
+func GroupByInPlace[T any, K comparable](list *List[T], key func(T) K) map[K]*List[T] {
+	groups := map[K]*List[T]{}
+	list.unlinkIf(func(T) bool { return true }, func(val T) *List[T] {
+		return groupOf(groups, key(val))
+	})
+	return groups
+}
+
+// Zip returns a new List pairing the elements of a and b at the same
+// position, as long as the shorter of the two lists
+func Zip[T, U any](a *List[T], b *List[U]) *List[Pair[T, U]] {
+	zipped := New[Pair[T, U]]()
+	for x, y := a.head.next, b.head.next; x != nil && y != nil; x, y = x.next, y.next {
+		zipped.Append(Pair[T, U]{x.data, y.data})
+	}
+	return zipped
+}
+
+// Take returns a new List holding the first n elements of the list, or all of
+// them if there are fewer
+func (list *List[T]) Take(n uint) *List[T] {
+	taken := New[T]()
+	for current := list.head.next; current != nil && taken.size < n; current = current.next {
+		taken.Append(current.data)
+	}
+	return taken
+}
+
+// TakeInPlace removes the elements after the first n from the list
+func (list *List[T]) TakeInPlace(n uint) {
+	list.sync()
+	last := list.head
+	for i := uint(0); i < n && last.next != nil; i++ {
+		last = last.next
+	}
+	list.truncateAfter(last)
+}
+
+// Drop returns a new List holding the elements after the first n of the list
+func (list *List[T]) Drop(n uint) *List[T] {
+	current := list.head.next
+	for i := uint(0); i < n && current != nil; i++ {
+		current = current.next
+	}
+	dropped := New[T]()
+	for ; current != nil; current = current.next {
+		dropped.Append(current.data)
+	}
+	return dropped
+}
+
+// DropInPlace removes the first n elements from the list, or all of them if
+// there are fewer
+func (list *List[T]) DropInPlace(n uint) {
+	list.sync()
+	for i := uint(0); i < n && list.head.next != nil; i++ {
+		node := list.head.next
+		list.head.next = node.next
+		list.detach(list.head, node)
+	}
+	list.debugValidate()
+}
+
+// TakeWhile returns a new List holding the elements of the list up to, but
+// not including, the first one not satisfying pred
+func (list *List[T]) TakeWhile(pred func(T) bool) *List[T] {
+	taken := New[T]()
+	for current := list.head.next; current != nil && pred(current.data); current = current.next {
+		taken.Append(current.data)
+	}
+	return taken
+}
+
+// TakeWhileInPlace removes from the list the first element not satisfying
+// pred and all the elements after it
+func (list *List[T]) TakeWhileInPlace(pred func(T) bool) {
+	list.sync()
+	last := list.head
+	for last.next != nil && pred(last.next.data) {
+		last = last.next
+	}
+	list.truncateAfter(last)
+}
+
+// unlinkIf unlinks the nodes whose element satisfies pred and returns how many
+// there were. If into is not nil, each node is then appended to the List it
+// returns for the element, otherwise the node is discarded
+func (list *List[T]) unlinkIf(pred func(T) bool, into func(T) *List[T]) uint {
+	list.sync()
+	count := uint(0)
+	previous := list.head
+	for previous.next != nil {
+		node := previous.next
+		if !pred(node.data) {
+			previous = node
+			continue
+		}
+		previous.next = node.next
+		list.detach(previous, node)
+		count++
+		if into != nil {
+			into(node.data).appendNode(node)
+		}
+	}
+	list.debugValidate()
+	return count
+}
+
+// truncateAfter unlinks all the nodes after last, which must be the head or a
+// node of the list
+func (list *List[T]) truncateAfter(last *Node[T]) {
+	for last.next != nil {
+		node := last.next
+		last.next = node.next
+		list.detach(last, node)
+	}
+	list.debugValidate()
+}
+
+// appendNode links a node which belongs to no List at the end of the list
+func (list *List[T]) appendNode(node *Node[T]) {
+	list.sync()
+	previous := list.tail
+	previous.next = node
+	list.attach(previous, node)
+	list.debugValidate()
+}
+
+// groupOf returns the List of the key in groups, created if there is none
+func groupOf[T any, K comparable](groups map[K]*List[T], key K) *List[T] {
+	group, ok := groups[key]
+	if !ok {
+		group = New[T]()
+		groups[key] = group
+	}
+	return group
+}
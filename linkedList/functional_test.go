@@ -0,0 +1,189 @@
+package linkedList
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(val int) bool { return val%2 == 0 }
+
+// assertIntList checks the elements of the list together with its cached size
+// and tail
+func assertIntList(assert *assert.Assertions, list *List[int], expected ...int) {
+	assert.Equal(append([]int{}, expected...), slices.AppendSeq([]int{}, list.Values()))
+	assert.Equal(uint(len(expected)), list.Size())
+	tail, ok := list.Tail()
+	if len(expected) == 0 {
+		assert.False(ok)
+	} else {
+		assert.Equal(expected[len(expected)-1], tail)
+	}
+	assert.Nil(list.Validate())
+}
+
+func TestListMap(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newIntList(1, 2, 3)
+	mapped := Map(list, strconv.Itoa)
+	assert.Equal("[1 2 3]", mapped.String())
+	assert.Equal([]string{"1", "2", "3"}, slices.Collect(mapped.Values()))
+	assert.Equal(uint(3), mapped.Size())
+	assert.Equal(uint(0), Map(New[int](), strconv.Itoa).Size())
+
+	node, _ := list.GetNode(1)
+	list.MapInPlace(func(val int) int { return val * 10 })
+	assertIntList(assert, list, 10, 20, 30)
+	assert.Equal(20, node.Val())
+}
+
+func TestListFilter(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(1, 2, 3, 4, 5, 6)
+	assertIntList(assert, list.Filter(isEven), 2, 4, 6)
+	assertIntList(assert, list, 1, 2, 3, 4, 5, 6)
+
+	removed, _ := list.GetNode(5)
+	list.FilterInPlace(func(val int) bool { return val < 4 })
+	assertIntList(assert, list, 1, 2, 3)
+
+	// A removed node no longer affects the list
+	assert.Nil(removed.Next())
+	removed.InsertAfter(7)
+	assert.Equal(uint(3), list.Size())
+
+	list.FilterInPlace(func(int) bool { return false })
+	assertIntList(assert, list)
+}
+
+func TestListReduceFold(t *testing.T) {
+	assert := assert.New(t)
+
+	sum := func(acc, val int) int { return acc + val }
+	total, ok := newIntList(1, 2, 3, 4).Reduce(sum)
+	assert.True(ok)
+	assert.Equal(10, total)
+	total, ok = newIntList(7).Reduce(sum)
+	assert.Equal(7, total)
+	_, ok = New[int]().Reduce(sum)
+	assert.False(ok)
+
+	joined := Fold(newIntList(1, 2, 3), "", func(acc string, val int) string {
+		return acc + strconv.Itoa(val)
+	})
+	assert.Equal("123", joined)
+	assert.Equal("init", Fold(New[int](), "init", func(acc string, val int) string { return "" }))
+}
+
+func TestListAnyEvery(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(newIntList(1, 2, 3).Any(isEven))
+	assert.False(newIntList(1, 3).Any(isEven))
+	assert.False(New[int]().Any(isEven))
+
+	assert.True(newIntList(2, 4).Every(isEven))
+	assert.False(newIntList(2, 3).Every(isEven))
+	assert.True(New[int]().Every(isEven))
+}
+
+func TestListFindAll(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]int{1, 3, 4}, newIntList(1, 2, 3, 4, 6).FindAll(isEven))
+	assert.Equal([]int{}, newIntList(1, 3).FindAll(isEven))
+}
+
+func TestListPartition(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(1, 2, 3, 4, 5)
+	even, odd := list.Partition(isEven)
+	assertIntList(assert, even, 2, 4)
+	assertIntList(assert, odd, 1, 3, 5)
+	assertIntList(assert, list, 1, 2, 3, 4, 5)
+
+	moved, _ := list.GetNode(2)
+	rest := list.PartitionInPlace(isEven)
+	assertIntList(assert, list, 2, 4)
+	assertIntList(assert, rest, 1, 3, 5)
+
+	// The moved node now belongs to rest
+	moved.InsertAfter(4)
+	assertIntList(assert, rest, 1, 3, 4, 5)
+	assertIntList(assert, list, 2, 4)
+}
+
+func TestListGroupBy(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	mod3 := func(val int) int { return val % 3 }
+	list := newIntList(1, 2, 3, 4, 5, 6, 7)
+	groups := GroupBy(list, mod3)
+	assert.Equal(3, len(groups))
+	assertIntList(assert, groups[0], 3, 6)
+	assertIntList(assert, groups[1], 1, 4, 7)
+	assertIntList(assert, groups[2], 2, 5)
+	assertIntList(assert, list, 1, 2, 3, 4, 5, 6, 7)
+
+	first, _ := list.GetNode(0)
+	groups = GroupByInPlace(list, mod3)
+	assertIntList(assert, groups[0], 3, 6)
+	assertIntList(assert, groups[1], 1, 4, 7)
+	assertIntList(assert, groups[2], 2, 5)
+	assertIntList(assert, list)
+
+	first.InsertAfter(10)
+	assertIntList(assert, groups[1], 1, 10, 4, 7)
+
+	assert.Empty(GroupBy(New[int](), mod3))
+}
+
+func TestListZip(t *testing.T) {
+	assert := assert.New(t)
+
+	words := New[string]()
+	words.Append("a")
+	words.Append("b")
+	zipped := Zip(newIntList(1, 2, 3), words)
+	assert.Equal([]Pair[int, string]{{1, "a"}, {2, "b"}}, slices.Collect(zipped.Values()))
+	assert.Equal(uint(2), zipped.Size())
+	assert.True(Zip(New[int](), words).IsEmpty())
+}
+
+func TestListTakeDrop(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(1, 2, 3, 4, 5)
+	assertIntList(assert, list.Take(2), 1, 2)
+	assertIntList(assert, list.Take(0))
+	assertIntList(assert, list.Take(9), 1, 2, 3, 4, 5)
+	assertIntList(assert, list.Drop(2), 3, 4, 5)
+	assertIntList(assert, list.Drop(9))
+	assertIntList(assert, list.TakeWhile(func(val int) bool { return val < 3 }), 1, 2)
+	assertIntList(assert, list.TakeWhile(isEven))
+	assertIntList(assert, list, 1, 2, 3, 4, 5)
+
+	list.TakeInPlace(4)
+	assertIntList(assert, list, 1, 2, 3, 4)
+	list.DropInPlace(1)
+	assertIntList(assert, list, 2, 3, 4)
+	list.TakeWhileInPlace(func(val int) bool { return val < 4 })
+	assertIntList(assert, list, 2, 3)
+	list.TakeInPlace(9)
+	assertIntList(assert, list, 2, 3)
+	list.DropInPlace(9)
+	assertIntList(assert, list)
+
+	list = newIntList(1, 2)
+	list.TakeInPlace(0)
+	assertIntList(assert, list)
+}
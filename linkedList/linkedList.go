@@ -3,71 +3,78 @@ package linkedList
 import (
 	"bytes"
 	"errors"
+	"iter"
 	"strings"
 )
 
-type LinkedList struct {
-	head *LinkedNode
+type List[T any] struct {
+	head *Node[T]
+	// tail points to the last node, or to head when the list is empty
+	tail *Node[T]
+	size uint
+	// dirty is set when the nodes are relinked outside of the List methods,
+	// tail and size are then recounted by sync before their next use
+	dirty bool
+	owned *ownership[T]
 }
 
-func NewLinkedList() *LinkedList {
-	return &LinkedList{NewLinkedNode()}
+// New creates and returns an empty List holding elements of type T
+func New[T any]() *List[T] {
+	list := &List[T]{head: NewNode[T]()}
+	list.tail = list.head
+	list.owned = &ownership[T]{list: list}
+	return list
 }
 
 // Head returns the first element of the list, second returned value will be
 // false if the list is empty
-func (list *LinkedList) Head() (interface{}, bool) {
+func (list *List[T]) Head() (T, bool) {
 	if list.head.Next() == nil {
-		return nil, false
+		var zero T
+		return zero, false
 	}
 	return list.head.Next().Val(), true
 }
 
 // Tail returns the last element of the list, second returned value will be
 // false if the list is empty
-func (list *LinkedList) Tail() (interface{}, bool) {
-	if list.head.Next() == nil {
-		return nil, false
-	}
-	tail := list.head.Next()
-	for tail.Next() != nil {
-		tail = tail.Next()
+func (list *List[T]) Tail() (T, bool) {
+	list.sync()
+	if list.tail == list.head {
+		var zero T
+		return zero, false
 	}
-	return tail.Val(), true
+	return list.tail.Val(), true
 }
 
 // IsEmpty returns whether the list is empty
-func (list *LinkedList) IsEmpty() bool {
+func (list *List[T]) IsEmpty() bool {
 	return list.head.Next() == nil
 }
 
 // Size returns the number of element(s) in the list
-func (list *LinkedList) Size() uint {
-	var count uint = 0
-	current := list.head.Next()
-	for current != nil {
-		current = current.Next()
-		count++
-	}
-	return count
+func (list *List[T]) Size() uint {
+	list.sync()
+	return list.size
 }
 
 // Get returns the element at the specified position, or error if the position
 // is invalid
-func (list *LinkedList) Get(pos uint) (interface{}, error) {
-	current := list.head.Next()
-	for i := uint(0); i < pos && current != nil; i++ {
-		current = current.Next()
-	}
-	if current == nil {
-		return nil, errors.New("Invalid position")
+func (list *List[T]) Get(pos uint) (T, error) {
+	current, err := list.GetNode(pos)
+	if err != nil {
+		var zero T
+		return zero, err
 	}
 	return current.Val(), nil
 }
 
-// GetNode returns the pointer to the LinkedNode at the spcified position, or
+// GetNode returns the pointer to the Node at the spcified position, or
 // error if the position is invalid
-func (list *LinkedList) GetNode(pos uint) (*LinkedNode, error) {
+func (list *List[T]) GetNode(pos uint) (*Node[T], error) {
+	if !list.dirty && list.size > 0 && pos == list.size-1 {
+		return list.tail, nil
+	}
 	current := list.head.Next()
 	for i := uint(0); i < pos && current != nil; i++ {
 		current = current.Next()
@@ -79,49 +86,191 @@ func (list *LinkedList) GetNode(pos uint) (*LinkedNode, error) {
 }
 
 // Append inserts the provided data to the end of the list and return the
-// pointer to the LinkedNode just appended
-func (list *LinkedList) Append(data interface{}) *LinkedNode {
-	current := list.head
-	for current.Next() != nil {
-		current = current.Next()
-	}
-	return current.InsertAfter(data)
+// pointer to the Node just appended
+func (list *List[T]) Append(data T) *Node[T] {
+	list.sync()
+	node := list.insertAfter(list.tail, data)
+	list.debugValidate()
+	return node
 }
 
 // Insert inserts the provided data to specified position of the list, and
-// return the pointer to LinkedNode, or error if the position is invalid
-func (list *LinkedList) Insert(pos uint, data interface{}) (*LinkedNode, error) {
-	previous := list.head
-	i := uint(0)
-	for ; i < pos && previous.Next() != nil; i++ {
-		previous = previous.Next()
-	}
-	if i < pos {
+// return the pointer to Node, or error if the position is invalid
+func (list *List[T]) Insert(pos uint, data T) (*Node[T], error) {
+	list.sync()
+	if pos > list.size {
 		return nil, errors.New("Invalid position")
 	}
-	return previous.InsertAfter(data), nil
+	previous := list.tail
+	if pos < list.size {
+		previous = list.head
+		for i := uint(0); i < pos; i++ {
+			previous = previous.Next()
+		}
+	}
+	node := list.insertAfter(previous, data)
+	list.debugValidate()
+	return node, nil
 }
 
 // Delete removes an element at the specified position and returns the deleted
 // element, or error if the position is invalid
-func (list *LinkedList) Delete(pos uint) (interface{}, error) {
+func (list *List[T]) Delete(pos uint) (T, error) {
 	previous := list.head
 	i := uint(0)
 	for ; i < pos && previous.Next() != nil; i++ {
 		previous = previous.Next()
 	}
-	if i < pos {
+	if i < pos || previous.Next() == nil {
+		var zero T
+		return zero, errors.New("Invalid position")
+	}
+	list.sync()
+	deleted := previous.Next()
+	previous.next = deleted.Next()
+	list.detach(previous, deleted)
+	list.debugValidate()
+
+	return deleted.Val(), nil
+}
+
+// DeleteRange removes the elements from position from up to, but not
+// including, position to and returns them in order, or error if the range is
+// invalid
+func (list *List[T]) DeleteRange(from, to uint) ([]T, error) {
+	list.sync()
+	if from > to || to > list.size {
 		return nil, errors.New("Invalid position")
 	}
-	tmp := previous.Next().Val()
-	previous.SetNext(previous.Next().Next())
+	previous := list.head
+	for i := uint(0); i < from; i++ {
+		previous = previous.Next()
+	}
+	deleted := make([]T, 0, to-from)
+	for i := from; i < to; i++ {
+		node := previous.next
+		previous.next = node.next
+		list.detach(previous, node)
+		deleted = append(deleted, node.data)
+	}
+	list.debugValidate()
+	return deleted, nil
+}
 
-	return tmp, nil
+// RemoveFunc removes every element satisfying pred in a single pass and
+// returns the number of elements removed
+func (list *List[T]) RemoveFunc(pred func(T) bool) int {
+	return int(list.unlinkIf(pred, nil))
 }
 
-// Find searches the LinkedList for the first element satisfying the provided
+// RemoveFirst removes the first element satisfying pred and returns it,
+// second returned value will be false if no element satisfies pred
+func (list *List[T]) RemoveFirst(pred func(T) bool) (T, bool) {
+	list.sync()
+	for previous := list.head; previous.next != nil; previous = previous.next {
+		if node := previous.next; pred(node.data) {
+			previous.next = node.next
+			list.detach(previous, node)
+			list.debugValidate()
+			return node.data, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// RemoveAfter removes the element following the node in O(1) and returns it,
+// or error if the node does not belong to the list or is its last node
+func (list *List[T]) RemoveAfter(node *Node[T]) (T, error) {
+	list.sync()
+	var zero T
+	if node == nil || node.owner() != list {
+		return zero, errors.New("Node does not belong to the list")
+	}
+	deleted := node.next
+	if deleted == nil {
+		return zero, errors.New("Node is the last of the list")
+	}
+	node.next = deleted.next
+	list.detach(node, deleted)
+	list.debugValidate()
+	return deleted.data, nil
+}
+
+// Dedupe keeps only the first element of each run of consecutive equal
+// elements and returns the number of elements removed. eq is called with the
+// first element of the run and the element checked. On a sorted list this
+// leaves unique elements
+func (list *List[T]) Dedupe(eq func(a, b T) bool) int {
+	list.sync()
+	removed := 0
+	current := list.head.next
+	for current != nil && current.next != nil {
+		if next := current.next; eq(current.data, next.data) {
+			current.next = next.next
+			list.detach(current, next)
+			removed++
+		} else {
+			current = next
+		}
+	}
+	list.debugValidate()
+	return removed
+}
+
+// insertAfter inserts a new node holding data right after previous, which
+// must be the head or a node of the list, and keeps size and tail up to date
+func (list *List[T]) insertAfter(previous *Node[T], data T) *Node[T] {
+	node := NewNodeWithVal(data)
+	node.next = previous.next
+	previous.next = node
+	list.attach(previous, node)
+	return node
+}
+
+// attach records that node has just been linked after previous
+func (list *List[T]) attach(previous, node *Node[T]) {
+	node.owned = list.owned
+	if list.dirty {
+		return
+	}
+	list.size++
+	if previous == list.tail {
+		list.tail = node
+	}
+}
+
+// detach records that node has just been unlinked from after previous
+func (list *List[T]) detach(previous, node *Node[T]) {
+	node.owned, node.next = nil, nil
+	list.size--
+	if node == list.tail {
+		list.tail = previous
+	}
+}
+
+// sync recounts size and tail if the nodes have been relinked directly through
+// Node.SetNext. Every reachable node is given a new ownership so that nodes
+// which were unlinked no longer report changes to the list
+func (list *List[T]) sync() {
+	if !list.dirty {
+		return
+	}
+	list.owned.list = nil
+	list.owned = &ownership[T]{list: list}
+	list.size = 0
+	list.tail = list.head
+	for current := list.head.Next(); current != nil; current = current.Next() {
+		current.owned = list.owned
+		list.size++
+		list.tail = current
+	}
+	list.dirty = false
+}
+
+// Find searches the List for the first element satisfying the provided
 // function and return the index (starting from 0)
-func (list *LinkedList) Find(fn func(interface{}) bool) int {
+func (list *List[T]) Find(fn func(T) bool) int {
 	current := list.head
 	for i := 0; current.Next() != nil; i++ {
 		current = current.Next()
@@ -132,7 +281,7 @@ func (list *LinkedList) Find(fn func(interface{}) bool) int {
 	return -1
 }
 
-func (list *LinkedList) FindByOccurence(fn func(interface{}) bool, occurence int) int {
+func (list *List[T]) FindByOccurence(fn func(T) bool, occurence int) int {
 	allMatches := make([]int, 0, list.Size()/2)
 	current := list.head
 	j := 0
@@ -160,7 +309,50 @@ func (list *LinkedList) FindByOccurence(fn func(interface{}) bool, occurence int
 	return -1
 }
 
-func (list *LinkedList) String() string {
+// All returns an iterator over the index and element pairs of the list, from
+// the first element to the last
+func (list *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		current := list.head.Next()
+		for i := 0; current != nil; i++ {
+			if !yield(i, current.Val()) {
+				return
+			}
+			current = current.Next()
+		}
+	}
+}
+
+// Backward returns an iterator over the index and element pairs of the list,
+// from the last element to the first. As the nodes only link forward, the
+// elements are collected first which takes O(n) memory
+func (list *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		els := make([]T, 0, list.Size())
+		for current := list.head.Next(); current != nil; current = current.Next() {
+			els = append(els, current.Val())
+		}
+		for i := len(els) - 1; i >= 0; i-- {
+			if !yield(i, els[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list, from the first
+// element to the last
+func (list *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := list.head.Next(); current != nil; current = current.Next() {
+			if !yield(current.Val()) {
+				return
+			}
+		}
+	}
+}
+
+func (list *List[T]) String() string {
 	var b bytes.Buffer
 	els := make([]string, 0, list.Size())
 
@@ -4,52 +4,98 @@ import (
 	"fmt"
 )
 
-type LinkedNode struct {
-	data interface{}
-	next *LinkedNode
+type Node[T any] struct {
+	data T
+	next *Node[T]
+	// owned leads to the List the node belongs to, nil if it belongs to none
+	owned *ownership[T]
 }
 
-// New creates and return a new empty LinkedNode
-func NewLinkedNode() *LinkedNode {
-	return &LinkedNode{nil, nil}
+// ownership is shared by the nodes of a List to find the List they belong to.
+// When all the nodes of a List are moved to another one, their ownership is
+// forwarded to the ownership of that List rather than updated node by node.
+// A List drops its ownership, and thus every node still referring to it,
+// whenever it recounts its nodes after they were relinked by hand
+type ownership[T any] struct {
+	list    *List[T]
+	forward *ownership[T]
 }
 
-// New creates and return the pointer to the created LinkedNode with the provided data
-func NewLinkedNodeWithVal(data interface{}) *LinkedNode {
-	return &LinkedNode{data, nil}
+// root follows the forwarding chain to the ownership in use, and shortens the
+// chain for the next lookups
+func (own *ownership[T]) root() *ownership[T] {
+	root := own
+	for root.forward != nil {
+		root = root.forward
+	}
+	for own != root {
+		next := own.forward
+		own.forward = root
+		own = next
+	}
+	return root
 }
 
-// Val returns the value of the LinkedNode
-func (node *LinkedNode) Val() interface{} {
+// NewNode creates and return a new empty Node holding the zero value of T
+func NewNode[T any]() *Node[T] {
+	return &Node[T]{}
+}
+
+// NewNodeWithVal creates and return the pointer to the created Node with the
+// provided data
+func NewNodeWithVal[T any](data T) *Node[T] {
+	return &Node[T]{data: data}
+}
+
+// Val returns the value of the Node
+func (node *Node[T]) Val() T {
 	return node.data
 }
 
-// SetVal set the provided value of the LinkedNode
-func (node *LinkedNode) SetVal(val interface{}) {
+// SetVal set the provided value of the Node
+func (node *Node[T]) SetVal(val T) {
 	node.data = val
 }
 
-// Next returns the pointer to the LinkedNode pointed by the next pointer,
-// nil if the pointer has not assigned any value
-func (node *LinkedNode) Next() *LinkedNode {
+// Next returns the pointer to the Node pointed by the next pointer, nil if the
+// pointer has not assigned any value
+func (node *Node[T]) Next() *Node[T] {
 	return node.next
 }
 
-// SetNext updates the next pointer to the specified LinkedNode
-func (node *LinkedNode) SetNext(next *LinkedNode) {
+// SetNext updates the next pointer to the specified Node. If the node belongs
+// to a List, the size and tail of that List are recounted on their next use
+func (node *Node[T]) SetNext(next *Node[T]) {
 	node.next = next
+	if list := node.owner(); list != nil {
+		list.dirty = true
+	}
 }
 
-// InsertAfter insert a LinkedNode with the specified data after the current
-// node and return the pointer to the LinkedNode just inserted
-func (node *LinkedNode) InsertAfter(data interface{}) *LinkedNode {
+// InsertAfter insert a Node with the specified data after the current node and
+// return the pointer to the Node just inserted
+func (node *Node[T]) InsertAfter(data T) *Node[T] {
 	tmp := node.next
-	node.next = NewLinkedNodeWithVal(data)
+	node.next = NewNodeWithVal(data)
 	node.next.next = tmp
 
+	if list := node.owner(); list != nil {
+		list.attach(node, node.next)
+		list.debugValidate()
+	}
 	return node.next
 }
 
-func (node *LinkedNode) String() string {
+// owner returns the List the node currently belongs to, nil if the node is
+// not part of any List or has been unlinked from it
+func (node *Node[T]) owner() *List[T] {
+	if node.owned == nil {
+		return nil
+	}
+	node.owned = node.owned.root()
+	return node.owned.list
+}
+
+func (node *Node[T]) String() string {
 	return fmt.Sprint(node.data)
 }
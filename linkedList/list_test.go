@@ -0,0 +1,602 @@
+package linkedList
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+
+	assert.Equal(NewNode[int](), list.head)
+	assert.Nil(list.head.Next())
+}
+
+func TestListHead(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+
+	val, ok := list.Head()
+	assert.Equal(0, val)
+	assert.Equal(false, ok)
+
+	list.Append(1)
+	val, ok = list.Head()
+	assert.Equal(1, val)
+	assert.Equal(true, ok)
+}
+
+func TestListTail(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[string]()
+
+	val, ok := list.Tail()
+	assert.Equal("", val)
+	assert.Equal(false, ok)
+
+	list.Append("a")
+	list.Append("b") // [a b]
+	val, ok = list.Tail()
+	assert.Equal("b", val)
+	assert.Equal(true, ok)
+}
+
+func TestListIsEmpty(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	assert.Equal(true, list.IsEmpty())
+
+	list.Append(1)
+	assert.Equal(false, list.IsEmpty())
+}
+
+func TestListSize(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	assert.Equal(uint(0), list.Size())
+
+	list.Append(1) // [1]
+	assert.Equal(uint(1), list.Size())
+
+	list.Append(2) // [1 2]
+	assert.Equal(uint(2), list.Size())
+}
+
+func TestListGet(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	list.Append(1)
+	list.Append(2) // [1 2]
+
+	val, err := list.Get(0)
+	assert.Equal(1, val)
+	assert.Nil(err)
+
+	val, err = list.Get(1)
+	assert.Equal(2, val)
+	assert.Nil(err)
+
+	val, err = list.Get(9999)
+	assert.Equal(0, val)
+	assert.Equal("Invalid position", err.Error())
+}
+
+func TestListGetNode(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	list.Append(1)
+	list.Append(2) // [1 2]
+
+	node, err := list.GetNode(0)
+	assert.Equal(1, node.Val())
+	assert.Nil(err)
+
+	node2, err := list.GetNode(1)
+	assert.Equal(2, node2.Val())
+	assert.Equal(node2, node.Next())
+	assert.Nil(err)
+
+	node, err = list.GetNode(9999)
+	assert.Nil(node)
+	assert.Equal("Invalid position", err.Error())
+}
+
+func TestListAppend(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	node := list.Append(1) // [1]
+
+	assert.Equal(node, list.head.Next())
+	assert.Equal(1, list.head.Next().Val())
+
+	node = list.Append(2) // [1 2]
+	assert.Equal(node, list.head.Next().Next())
+	assert.Equal(1, list.head.Next().Val())
+	assert.Equal(2, list.head.Next().Next().Val())
+}
+
+func TestListInsert(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	node, err := list.Insert(0, 1) // [1]
+	assert.Equal(1, list.head.Next().Val())
+	assert.Equal(list.head.Next(), node)
+	assert.Nil(err)
+
+	node, err = list.Insert(1, 3) // [1 3]
+	assert.Equal(3, list.head.Next().Next().Val())
+	assert.Equal(list.head.Next().Next(), node)
+	assert.Nil(err)
+
+	node, err = list.Insert(1, 2) // [1 2 3]
+	assert.Equal("[1 2 3]", list.String())
+	assert.Equal(list.head.Next().Next(), node)
+	assert.Nil(err)
+
+	node, err = list.Insert(9999, 1)
+	assert.Nil(node)
+	assert.Equal("Invalid position", err.Error())
+}
+
+func TestListDelete(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	list.Append(1)
+	list.Append(2)
+	list.Append(3)             // [1 2 3]
+	val, err := list.Delete(1) // [1 3]
+
+	assert.Equal(2, val)
+	assert.Nil(err)
+	assert.Equal("[1 3]", list.String())
+
+	val, err = list.Delete(9999)
+	assert.Equal(0, val)
+	assert.Equal("Invalid position", err.Error())
+
+	// Right past the last element
+	val, err = list.Delete(2)
+	assert.Equal(0, val)
+	assert.Equal("Invalid position", err.Error())
+	_, err = New[int]().Delete(0)
+	assert.Equal("Invalid position", err.Error())
+	assert.Equal("[1 3]", list.String())
+}
+
+func TestListRemoveFunc(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(2, 1, 2, 3, 2, 2)
+	assert.Equal(4, list.RemoveFunc(func(val int) bool { return val == 2 }))
+	assertIntList(assert, list, 1, 3)
+	assert.Equal(0, list.RemoveFunc(func(val int) bool { return val == 2 }))
+	assert.Equal(2, list.RemoveFunc(func(int) bool { return true }))
+	assertIntList(assert, list)
+}
+
+func TestListRemoveFirst(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(1, 2, 3, 4)
+	val, ok := list.RemoveFirst(func(val int) bool { return val > 1 })
+	assert.True(ok)
+	assert.Equal(2, val)
+	assertIntList(assert, list, 1, 3, 4)
+
+	val, ok = list.RemoveFirst(func(val int) bool { return val == 4 })
+	assert.Equal(4, val)
+	assertIntList(assert, list, 1, 3)
+
+	val, ok = list.RemoveFirst(func(val int) bool { return val > 9 })
+	assert.False(ok)
+	assert.Equal(0, val)
+}
+
+func TestListRemoveAfter(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(1, 2, 3)
+	first, _ := list.GetNode(0)
+	val, err := list.RemoveAfter(first)
+	assert.Nil(err)
+	assert.Equal(2, val)
+	assertIntList(assert, list, 1, 3)
+
+	val, err = list.RemoveAfter(first)
+	assert.Equal(3, val)
+	assertIntList(assert, list, 1)
+
+	_, err = list.RemoveAfter(first)
+	assert.Equal("Node is the last of the list", err.Error())
+
+	other := newIntList(1, 2)
+	foreign, _ := other.GetNode(0)
+	_, err = list.RemoveAfter(foreign)
+	assert.Equal("Node does not belong to the list", err.Error())
+	_, err = list.RemoveAfter(nil)
+	assert.Equal("Node does not belong to the list", err.Error())
+	_, err = list.RemoveAfter(NewNodeWithVal(1))
+	assert.Equal("Node does not belong to the list", err.Error())
+
+	// A node removed from the list no longer belongs to it
+	removed, _ := other.Delete(0)
+	assert.Equal(1, removed)
+	_, err = other.RemoveAfter(foreign)
+	assert.Equal("Node does not belong to the list", err.Error())
+	assertIntList(assert, other, 2)
+}
+
+func TestListDedupe(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	eq := func(a, b int) bool { return a == b }
+	list := newIntList(1, 1, 2, 3, 3, 3, 1, 4, 4)
+	assert.Equal(4, list.Dedupe(eq))
+	assertIntList(assert, list, 1, 2, 3, 1, 4)
+	assert.Equal(0, list.Dedupe(eq))
+	assert.Equal(0, New[int]().Dedupe(eq))
+
+	// eq compares each element with the first of its run
+	list = newIntList(1, 2, 3, 5, 6)
+	close := func(a, b int) bool { return b-a <= 1 }
+	assert.Equal(2, list.Dedupe(close))
+	assertIntList(assert, list, 1, 3, 5)
+}
+
+func TestListDeleteRange(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(1, 2, 3, 4, 5)
+	node, _ := list.GetNode(1)
+	vals, err := list.DeleteRange(1, 3)
+	assert.Nil(err)
+	assert.Equal([]int{2, 3}, vals)
+	assert.Equal("[1 4 5]", list.String())
+	assert.Nil(node.Next())
+
+	vals, err = list.DeleteRange(1, 3)
+	assert.Equal([]int{4, 5}, vals)
+	tail, _ := list.Tail()
+	assert.Equal(1, tail)
+	assert.Equal(uint(1), list.Size())
+
+	vals, err = list.DeleteRange(1, 1)
+	assert.Nil(err)
+	assert.Empty(vals)
+
+	_, err = list.DeleteRange(0, 2)
+	assert.Equal("Invalid position", err.Error())
+	_, err = list.DeleteRange(1, 0)
+	assert.Equal("Invalid position", err.Error())
+	assert.Equal("[1]", list.String())
+}
+
+func TestListFind(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	for _, val := range []int{1, 2, 3, 1, 1, 2, 3} {
+		list.Append(val)
+	}
+
+	assert.Equal(-1, list.Find(func(val int) bool { return val == 9999 }))
+	assert.Equal(0, list.Find(func(val int) bool { return val == 1 }))
+	assert.Equal(1, list.Find(func(val int) bool { return val == 2 }))
+	assert.Equal(2, list.Find(func(val int) bool { return val > 2 }))
+}
+
+func TestListFindByOccurence(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	for _, val := range []int{1, 2, 3, 1, 1, 2, 3} {
+		list.Append(val)
+	}
+
+	find9999 := func(val int) bool { return val == 9999 }
+	find1 := func(val int) bool { return val == 1 }
+
+	assert.Equal(-1, list.FindByOccurence(find9999, 1))
+	assert.Equal(0, list.FindByOccurence(find1, 1))
+	assert.Equal(3, list.FindByOccurence(find1, 2))
+	assert.Equal(4, list.FindByOccurence(find1, -1))
+	assert.Equal(-1, list.FindByOccurence(find1, 5))
+	assert.Equal(-1, list.FindByOccurence(find1, -4))
+}
+
+func TestListString(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[string]()
+	assert.Equal("[]", list.String())
+
+	list.Append("a")
+	list.Append("b")
+	list.Append("c")
+	assert.Equal("[a b c]", list.String())
+}
+
+func TestNewNode(t *testing.T) {
+	assert := assert.New(t)
+
+	node := NewNode[int]()
+	assert.Equal(0, node.data)
+	assert.Nil(node.next)
+
+	strNode := NewNodeWithVal("a")
+	assert.Equal("a", strNode.data)
+	assert.Nil(strNode.next)
+}
+
+func TestNodeSetVal(t *testing.T) {
+	assert := assert.New(t)
+
+	node := NewNodeWithVal(1)
+	node.SetVal(2)
+	assert.Equal(2, node.Val())
+}
+
+func TestNodeInsertAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	node := NewNodeWithVal(1)
+	node3 := node.InsertAfter(3)
+	node2 := node.InsertAfter(2)
+	assert.Equal(node2, node.Next())
+	assert.Equal(node3, node.Next().Next())
+	assert.Nil(node3.Next())
+}
+
+func TestNodeString(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("1", NewNodeWithVal(1).String())
+	assert.Equal("dummy", NewNodeWithVal(dummy{}).String())
+}
+
+func TestListSizeAndTailAfterMutations(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := New[int]()
+	list.Insert(0, 2)
+	list.Insert(0, 1)
+	list.Insert(2, 4)
+	list.Insert(2, 3) // [1 2 3 4]
+	assert.Equal(uint(4), list.Size())
+	tail, _ := list.Tail()
+	assert.Equal(4, tail)
+
+	list.Delete(3) // [1 2 3]
+	assert.Equal(uint(3), list.Size())
+	tail, _ = list.Tail()
+	assert.Equal(3, tail)
+
+	list.Delete(0)
+	list.Delete(0)
+	list.Delete(0) // []
+	assert.Equal(uint(0), list.Size())
+	_, ok := list.Tail()
+	assert.Equal(false, ok)
+
+	list.Append(5) // [5]
+	assert.Equal("[5]", list.String())
+	assert.Equal(uint(1), list.Size())
+}
+
+func TestListSizeAndTailAfterNodeInsertAfter(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := New[int]()
+	first := list.Append(1)
+	list.Append(3)
+	first.InsertAfter(2) // [1 2 3]
+	assert.Equal(uint(3), list.Size())
+
+	last, _ := list.GetNode(2)
+	last.InsertAfter(4) // [1 2 3 4]
+	assert.Equal(uint(4), list.Size())
+	tail, _ := list.Tail()
+	assert.Equal(4, tail)
+
+	list.Append(5)
+	assert.Equal("[1 2 3 4 5]", list.String())
+	assert.Equal(uint(5), list.Size())
+}
+
+func TestListSizeAndTailAfterNodeSetNext(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := New[int]()
+	for i := 1; i <= 5; i++ {
+		list.Append(i)
+	}
+	second, _ := list.GetNode(1)
+	removed := second.Next()
+
+	second.SetNext(nil) // [1 2]
+	assert.Equal(uint(2), list.Size())
+	tail, _ := list.Tail()
+	assert.Equal(2, tail)
+
+	// Nodes which have been unlinked no longer affect the list
+	removed.InsertAfter(9)
+	removed.SetNext(nil)
+	assert.Equal(uint(2), list.Size())
+
+	// Hand-linked chains are counted once attached
+	chain := NewNodeWithVal(3)
+	chain.InsertAfter(4)
+	second.SetNext(chain) // [1 2 3 4]
+	assert.Equal(uint(4), list.Size())
+	tail, _ = list.Tail()
+	assert.Equal(4, tail)
+
+	list.Append(5)
+	chain.Next().InsertAfter(6) // [1 2 3 4 6 5]
+	assert.Equal("[1 2 3 4 6 5]", list.String())
+	assert.Equal(uint(6), list.Size())
+	tail, _ = list.Tail()
+	assert.Equal(5, tail)
+}
+
+func TestListDeletedNodeIsDetached(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := New[int]()
+	list.Append(1)
+	node := list.Append(2)
+	list.Delete(1)
+
+	node.InsertAfter(3)
+	node.SetNext(nil)
+	assert.Equal(uint(1), list.Size())
+	assert.Equal("[1]", list.String())
+}
+
+// appendByWalking appends to the list the way Append used to, by walking the
+// chain from the head to find the last node
+func appendByWalking(list *List[int], val int) {
+	current := list.head
+	for current.Next() != nil {
+		current = current.Next()
+	}
+	current.InsertAfter(val)
+}
+
+func benchmarkListAppend(b *testing.B, n int, fn func(*List[int], int)) {
+	for i := 0; i < b.N; i++ {
+		list := New[int]()
+		for j := 0; j < n; j++ {
+			fn(list, j)
+		}
+	}
+}
+
+func BenchmarkListAppend1000(b *testing.B) {
+	benchmarkListAppend(b, 1000, func(list *List[int], val int) { list.Append(val) })
+}
+
+func BenchmarkListAppendByWalking1000(b *testing.B) {
+	benchmarkListAppend(b, 1000, appendByWalking)
+}
+
+func BenchmarkListAppend10000(b *testing.B) {
+	benchmarkListAppend(b, 10000, func(list *List[int], val int) { list.Append(val) })
+}
+
+func BenchmarkListAppendByWalking10000(b *testing.B) {
+	benchmarkListAppend(b, 10000, appendByWalking)
+}
+
+func BenchmarkListSize(b *testing.B) {
+	list := New[int]()
+	for i := 0; i < 10000; i++ {
+		list.Append(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Size()
+	}
+}
+
+func BenchmarkListTail(b *testing.B) {
+	list := New[int]()
+	for i := 0; i < 10000; i++ {
+		list.Append(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Tail()
+	}
+}
+
+func TestListAll(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[string]()
+	for _, val := range []string{"a", "b", "c"} {
+		list.Append(val)
+	}
+
+	indices := []int{}
+	vals := []string{}
+	for i, val := range list.All() {
+		indices = append(indices, i)
+		vals = append(vals, val)
+	}
+	assert.Equal([]int{0, 1, 2}, indices)
+	assert.Equal([]string{"a", "b", "c"}, vals)
+
+	vals = []string{}
+	for _, val := range list.All() {
+		if val == "b" {
+			break
+		}
+		vals = append(vals, val)
+	}
+	assert.Equal([]string{"a"}, vals)
+
+	for range New[int]().All() {
+		assert.Fail("empty list should not yield")
+	}
+}
+
+func TestListBackward(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[string]()
+	for _, val := range []string{"a", "b", "c"} {
+		list.Append(val)
+	}
+
+	indices := []int{}
+	vals := []string{}
+	for i, val := range list.Backward() {
+		indices = append(indices, i)
+		vals = append(vals, val)
+		if i == 1 {
+			break
+		}
+	}
+	assert.Equal([]int{2, 1}, indices)
+	assert.Equal([]string{"c", "b"}, vals)
+}
+
+func TestListValues(t *testing.T) {
+	assert := assert.New(t)
+
+	list := New[int]()
+	for i := 1; i <= 5; i++ {
+		list.Append(i)
+	}
+
+	sum := 0
+	for val := range list.Values() {
+		if val > 3 {
+			break
+		}
+		sum += val
+	}
+	assert.Equal(6, sum)
+}
@@ -0,0 +1,123 @@
+package linkedList
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// PList is a persistent singly linked list. A PList is never modified once
+// created: Cons and Tail return other versions sharing the nodes of the
+// receiver, so any version may be read by several goroutines without locking.
+// The nil *PList is the empty list
+type PList[T any] struct {
+	head T
+	tail *PList[T]
+	size uint
+}
+
+// NewPList creates and returns a PList holding the provided elements in order
+func NewPList[T any](vals ...T) *PList[T] {
+	var list *PList[T]
+	for i := len(vals) - 1; i >= 0; i-- {
+		list = list.Cons(vals[i])
+	}
+	return list
+}
+
+// Cons returns a new version of the list with the provided element in front,
+// sharing all the nodes of the list. The list itself is left untouched
+func (list *PList[T]) Cons(val T) *PList[T] {
+	return &PList[T]{head: val, tail: list, size: list.Size() + 1}
+}
+
+// Head returns the first element of the list, second returned value will be
+// false if the list is empty
+func (list *PList[T]) Head() (T, bool) {
+	if list == nil {
+		var zero T
+		return zero, false
+	}
+	return list.head, true
+}
+
+// Tail returns the list without its first element, which is shared with the
+// list rather than copied. The tail of the empty list is the empty list
+func (list *PList[T]) Tail() *PList[T] {
+	if list == nil {
+		return nil
+	}
+	return list.tail
+}
+
+// IsEmpty returns whether the list is empty
+func (list *PList[T]) IsEmpty() bool {
+	return list == nil
+}
+
+// Size returns the number of element(s) in the list
+func (list *PList[T]) Size() uint {
+	if list == nil {
+		return 0
+	}
+	return list.size
+}
+
+// Get returns the element at the specified position, or error if the position
+// is invalid
+func (list *PList[T]) Get(pos uint) (T, error) {
+	current := list
+	for i := uint(0); i < pos && current != nil; i++ {
+		current = current.tail
+	}
+	if current == nil {
+		var zero T
+		return zero, errors.New("Invalid position")
+	}
+	return current.head, nil
+}
+
+// Reverse returns a new list with the elements in reverse order. No node can
+// be shared so the whole list is copied
+func (list *PList[T]) Reverse() *PList[T] {
+	var reversed *PList[T]
+	for current := list; current != nil; current = current.tail {
+		reversed = reversed.Cons(current.head)
+	}
+	return reversed
+}
+
+// All returns an iterator over the index and element pairs of the list, from
+// the first element to the last
+func (list *PList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		current := list
+		for i := 0; current != nil; i++ {
+			if !yield(i, current.head) {
+				return
+			}
+			current = current.tail
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list, from the first
+// element to the last
+func (list *PList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := list; current != nil; current = current.tail {
+			if !yield(current.head) {
+				return
+			}
+		}
+	}
+}
+
+func (list *PList[T]) String() string {
+	els := make([]string, 0, list.Size())
+	for current := list; current != nil; current = current.tail {
+		els = append(els, fmt.Sprint(current.head))
+	}
+	return "[" + strings.Join(els, " ") + "]"
+}
@@ -0,0 +1,91 @@
+package linkedList
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPList(t *testing.T) {
+	assert := assert.New(t)
+
+	var empty *PList[int]
+	assert.True(empty.IsEmpty())
+	assert.Equal(uint(0), empty.Size())
+	assert.Nil(empty.Tail())
+	_, ok := empty.Head()
+	assert.False(ok)
+	assert.Equal("[]", empty.String())
+
+	list := NewPList(1, 2, 3)
+	assert.Equal(uint(3), list.Size())
+	head, ok := list.Head()
+	assert.True(ok)
+	assert.Equal(1, head)
+	assert.Equal("[1 2 3]", list.String())
+	assert.Equal("[3 2 1]", list.Reverse().String())
+
+	val, err := list.Get(2)
+	assert.Nil(err)
+	assert.Equal(3, val)
+	_, err = list.Get(3)
+	assert.Equal("Invalid position", err.Error())
+
+	assert.Equal([]int{1, 2, 3}, slices.Collect(list.Values()))
+}
+
+func TestPListVersions(t *testing.T) {
+	assert := assert.New(t)
+
+	base := NewPList("b", "c")
+	withA := base.Cons("a")
+	withX := base.Cons("x")
+	tail := withA.Tail()
+
+	assert.Equal("[b c]", base.String())
+	assert.Equal("[a b c]", withA.String())
+	assert.Equal("[x b c]", withX.String())
+	assert.Equal(uint(3), withX.Size())
+
+	// The unchanged suffix is shared, not copied
+	assert.True(base == withA.Tail())
+	assert.True(base == withX.Tail())
+	assert.True(base == tail)
+}
+
+func TestPListConcurrentReaders(t *testing.T) {
+	assert := assert.New(t)
+
+	versions := []*PList[int]{nil}
+	for i := 0; i < 100; i++ {
+		versions = append(versions, versions[i].Cons(i))
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Readers walk every version while the writer below keeps deriving
+			// new ones from them
+			for i, version := range versions {
+				n := 0
+				for val := range version.Values() {
+					assert.Equal(i-1-n, val)
+					n++
+				}
+				assert.Equal(i, n)
+			}
+		}()
+	}
+	for i := 0; i < 1000; i++ {
+		versions[i%len(versions)].Cons(-1).Tail()
+	}
+	wg.Wait()
+
+	for i, version := range versions {
+		assert.Equal(uint(i), version.Size())
+	}
+}
@@ -0,0 +1,191 @@
+package linkedList
+
+import "errors"
+
+// Concat moves the elements of other to the end of the list in O(1), leaving
+// other empty. The nodes of other belong to the list afterwards. Concatenating
+// a list with itself has no effect
+func (list *List[T]) Concat(other *List[T]) {
+	if other == list {
+		return
+	}
+	list.sync()
+	other.sync()
+	if other.size > 0 {
+		list.tail.next = other.head.next
+		list.tail = other.tail
+		list.size += other.size
+	}
+	list.absorb(other)
+	list.debugValidate()
+}
+
+// SplitAt moves the first pos elements of the list to a new List and the rest
+// to another one, leaving the list empty, and returns both. Runs in O(pos).
+// Returns error if the position is greater than the size of the list
+func (list *List[T]) SplitAt(pos uint) (*List[T], *List[T], error) {
+	list.sync()
+	if pos > list.size {
+		return nil, nil, errors.New("Invalid position")
+	}
+	front, back := New[T](), New[T]()
+	last := list.head
+	for i := uint(0); i < pos; i++ {
+		last = last.next
+		last.owned = front.owned
+	}
+	if pos > 0 {
+		front.head.next, front.tail, front.size = list.head.next, last, pos
+	}
+	if last.next != nil {
+		back.head.next, back.tail, back.size = last.next, list.tail, list.size-pos
+		last.next = nil
+	}
+	// The nodes left with the ownership of the list are those of back
+	back.owned, list.owned.list = list.owned, back
+	list.reset()
+	front.debugValidate()
+	back.debugValidate()
+	return front, back, nil
+}
+
+// Splice moves the elements of other into the list so that the first of them
+// lands at the specified position, leaving other empty. Runs in O(pos), or in
+// O(1) when splicing at the end. Returns error if the position is greater
+// than the size of the list, or if other is the list itself
+func (list *List[T]) Splice(pos uint, other *List[T]) error {
+	list.sync()
+	if pos > list.size {
+		return errors.New("Invalid position")
+	}
+	if other == list {
+		return errors.New("Cannot splice a list into itself")
+	}
+	other.sync()
+	if other.size > 0 {
+		previous := list.tail
+		if pos < list.size {
+			previous = list.head
+			for i := uint(0); i < pos; i++ {
+				previous = previous.next
+			}
+		}
+		other.tail.next = previous.next
+		previous.next = other.head.next
+		if previous == list.tail {
+			list.tail = other.tail
+		}
+		list.size += other.size
+	}
+	list.absorb(other)
+	list.debugValidate()
+	return nil
+}
+
+// Reverse reverses the order of the elements in place by relinking the nodes
+func (list *List[T]) Reverse() {
+	list.sync()
+	if list.size < 2 {
+		return
+	}
+	list.tail = reverseAfter(list.head, list.size)
+	list.debugValidate()
+}
+
+// ReverseRange reverses in place the order of the elements from position i up
+// to, but not including, position j. Returns error if the range is invalid
+func (list *List[T]) ReverseRange(i, j uint) error {
+	list.sync()
+	if i > j || j > list.size {
+		return errors.New("Invalid position")
+	}
+	if j-i < 2 {
+		return nil
+	}
+	before := list.head
+	for k := uint(0); k < i; k++ {
+		before = before.next
+	}
+	last := reverseAfter(before, j-i)
+	if j == list.size {
+		list.tail = last
+	}
+	list.debugValidate()
+	return nil
+}
+
+// Rotate moves the first k elements to the end of the list, so that the
+// element at position k becomes the first, e.g. rotating [1 2 3 4] by 1 gives
+// [2 3 4 1]. k is taken modulo the size, so a negative k moves the last -k
+// elements to the front instead. Runs in O(k modulo the size)
+func (list *List[T]) Rotate(k int) {
+	list.sync()
+	if list.size < 2 {
+		return
+	}
+	size := int(list.size)
+	shift := ((k % size) + size) % size
+	if shift == 0 {
+		return
+	}
+	last := list.head
+	for i := 0; i < shift; i++ {
+		last = last.next
+	}
+	list.tail.next = list.head.next
+	list.head.next = last.next
+	last.next = nil
+	list.tail = last
+	list.debugValidate()
+}
+
+// Slice returns a new List holding a copy of the elements from position i up
+// to, but not including, position j, the list is left untouched. Returns
+// error if the range is invalid
+func (list *List[T]) Slice(i, j uint) (*List[T], error) {
+	list.sync()
+	if i > j || j > list.size {
+		return nil, errors.New("Invalid position")
+	}
+	sliced := New[T]()
+	current := list.head.next
+	for k := uint(0); k < j; k++ {
+		if k >= i {
+			sliced.Append(current.data)
+		}
+		current = current.next
+	}
+	return sliced, nil
+}
+
+// absorb hands the nodes of other, which must have been linked into the list,
+// over to the list and leaves other empty
+func (list *List[T]) absorb(other *List[T]) {
+	other.owned.list, other.owned.forward = nil, list.owned
+	other.reset()
+}
+
+// reset empties the list without touching its nodes, which must have been
+// handed over to another List
+func (list *List[T]) reset() {
+	list.head.next = nil
+	list.tail = list.head
+	list.size = 0
+	list.owned = &ownership[T]{list: list}
+}
+
+// reverseAfter reverses the order of the n nodes following before, n being at
+// least 1, and returns the last of them once reversed
+func reverseAfter[T any](before *Node[T], n uint) *Node[T] {
+	first := before.next
+	var previous *Node[T]
+	current := first
+	for i := uint(0); i < n; i++ {
+		next := current.next
+		current.next = previous
+		previous, current = current, next
+	}
+	before.next = previous
+	first.next = current
+	return first
+}
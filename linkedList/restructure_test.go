@@ -0,0 +1,252 @@
+package linkedList
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListConcat(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(1, 2)
+	other := newIntList(3, 4)
+	moved, _ := other.GetNode(0)
+	list.Concat(other)
+	assertIntList(assert, list, 1, 2, 3, 4)
+	assertIntList(assert, other)
+
+	// The moved nodes belong to the list, other stays usable on its own
+	moved.InsertAfter(9)
+	assertIntList(assert, list, 1, 2, 3, 9, 4)
+	other.Append(5)
+	assertIntList(assert, other, 5)
+	assertIntList(assert, list, 1, 2, 3, 9, 4)
+
+	list.Concat(New[int]())
+	list.Concat(list)
+	assertIntList(assert, list, 1, 2, 3, 9, 4)
+
+	empty := New[int]()
+	empty.Concat(list)
+	assertIntList(assert, empty, 1, 2, 3, 9, 4)
+	assertIntList(assert, list)
+}
+
+func TestListConcatChain(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	a, b, c := newIntList(1), newIntList(2), newIntList(3)
+	nodeB, _ := b.GetNode(0)
+	a.Concat(b)
+	c.Concat(a)
+	assertIntList(assert, c, 3, 1, 2)
+
+	// The node moved twice belongs to the last list
+	nodeB.InsertAfter(4)
+	assertIntList(assert, c, 3, 1, 2, 4)
+	assertIntList(assert, a)
+	assertIntList(assert, b)
+
+	// Once unlinked by hand and recounted, it no longer belongs to any list
+	first, _ := c.GetNode(1)
+	first.SetNext(nil)
+	assertIntList(assert, c, 3, 1)
+	nodeB.InsertAfter(5)
+	assertIntList(assert, c, 3, 1)
+}
+
+func TestListConcatDoesNotVisitNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	list, other := newIntList(1), newIntList(2, 3, 4)
+	owned := other.owned
+	list.Concat(other)
+
+	// The moved nodes keep the ownership of other, which is forwarded to the
+	// list instead of being replaced node by node
+	for i := uint(1); i < 4; i++ {
+		node, _ := list.GetNode(i)
+		assert.Equal(true, node.owned == owned)
+	}
+	assert.Equal(true, owned.forward == list.owned)
+	assert.Equal(true, other.owned != owned)
+	assertIntList(assert, list, 1, 2, 3, 4)
+}
+
+func TestListSplitAt(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	for pos := uint(0); pos <= 4; pos++ {
+		list := newIntList(1, 2, 3, 4)
+		nodes := make([]*Node[int], 4)
+		for i := range nodes {
+			nodes[i], _ = list.GetNode(uint(i))
+		}
+		front, back, err := list.SplitAt(pos)
+		assert.Nil(err)
+		all := []int{1, 2, 3, 4}
+		assertIntList(assert, front, all[:pos]...)
+		assertIntList(assert, back, all[pos:]...)
+		assertIntList(assert, list)
+
+		// Each node belongs to the list it was moved to
+		for i, node := range nodes {
+			node.InsertAfter(0)
+			if uint(i) < pos {
+				assert.Equal(pos+1, front.Size())
+				node.SetNext(node.Next().Next())
+			} else {
+				assert.Equal(4-pos+1, back.Size())
+				node.SetNext(node.Next().Next())
+			}
+		}
+		assertIntList(assert, front, all[:pos]...)
+		assertIntList(assert, back, all[pos:]...)
+		assert.Equal(uint(0), list.Size())
+	}
+
+	_, _, err := newIntList(1).SplitAt(2)
+	assert.Equal("Invalid position", err.Error())
+}
+
+func TestListSplice(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(1, 4)
+	other := newIntList(2, 3)
+	moved, _ := other.GetNode(1)
+	assert.Nil(list.Splice(1, other))
+	assertIntList(assert, list, 1, 2, 3, 4)
+	assertIntList(assert, other)
+	moved.InsertAfter(9)
+	assertIntList(assert, list, 1, 2, 3, 9, 4)
+
+	assert.Nil(list.Splice(0, newIntList(0)))
+	assertIntList(assert, list, 0, 1, 2, 3, 9, 4)
+	assert.Nil(list.Splice(6, newIntList(5, 6)))
+	assertIntList(assert, list, 0, 1, 2, 3, 9, 4, 5, 6)
+	assert.Nil(list.Splice(2, New[int]()))
+	assertIntList(assert, list, 0, 1, 2, 3, 9, 4, 5, 6)
+
+	other = newIntList(7)
+	assert.Equal("Invalid position", list.Splice(9, other).Error())
+	assertIntList(assert, other, 7)
+	assert.Equal("Cannot splice a list into itself", list.Splice(0, list).Error())
+	assertIntList(assert, list, 0, 1, 2, 3, 9, 4, 5, 6)
+}
+
+func TestListReverse(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := New[int]()
+	list.Reverse()
+	assertIntList(assert, list)
+
+	list = newIntList(1)
+	list.Reverse()
+	assertIntList(assert, list, 1)
+
+	list = newIntList(1, 2, 3, 4)
+	first, _ := list.GetNode(0)
+	list.Reverse()
+	assertIntList(assert, list, 4, 3, 2, 1)
+	first.InsertAfter(0)
+	assertIntList(assert, list, 4, 3, 2, 1, 0)
+}
+
+func TestListReverseRange(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(1, 2, 3, 4, 5)
+	assert.Nil(list.ReverseRange(1, 4))
+	assertIntList(assert, list, 1, 4, 3, 2, 5)
+	assert.Nil(list.ReverseRange(3, 5))
+	assertIntList(assert, list, 1, 4, 3, 5, 2)
+	assert.Nil(list.ReverseRange(0, 5))
+	assertIntList(assert, list, 2, 5, 3, 4, 1)
+	assert.Nil(list.ReverseRange(2, 3))
+	assert.Nil(list.ReverseRange(5, 5))
+	assertIntList(assert, list, 2, 5, 3, 4, 1)
+
+	assert.Equal("Invalid position", list.ReverseRange(3, 2).Error())
+	assert.Equal("Invalid position", list.ReverseRange(0, 6).Error())
+}
+
+func TestListRotate(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	cases := map[int][]int{
+		0:  {1, 2, 3, 4},
+		1:  {2, 3, 4, 1},
+		3:  {4, 1, 2, 3},
+		4:  {1, 2, 3, 4},
+		6:  {3, 4, 1, 2},
+		-1: {4, 1, 2, 3},
+		-5: {4, 1, 2, 3},
+	}
+	for k, expected := range cases {
+		list := newIntList(1, 2, 3, 4)
+		list.Rotate(k)
+		assertIntList(assert, list, expected...)
+	}
+
+	list := New[int]()
+	list.Rotate(3)
+	assertIntList(assert, list)
+}
+
+func TestListSlice(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := newIntList(1, 2, 3, 4)
+	sliced, err := list.Slice(1, 3)
+	assert.Nil(err)
+	assertIntList(assert, sliced, 2, 3)
+	assertIntList(assert, list, 1, 2, 3, 4)
+
+	// The copy does not share nodes with the list
+	node, _ := sliced.GetNode(0)
+	node.SetVal(9)
+	assertIntList(assert, list, 1, 2, 3, 4)
+
+	sliced, _ = list.Slice(2, 2)
+	assertIntList(assert, sliced)
+	sliced, _ = list.Slice(0, 4)
+	assertIntList(assert, sliced, 1, 2, 3, 4)
+
+	_, err = list.Slice(3, 2)
+	assert.Equal("Invalid position", err.Error())
+	_, err = list.Slice(0, 5)
+	assert.Equal("Invalid position", err.Error())
+}
+
+// benchmarkConcat concatenates a source of n elements, built before each run,
+// the time should not depend on n
+func benchmarkConcat(b *testing.B, n int) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list, other := New[int](), New[int]()
+		for j := 0; j < n; j++ {
+			other.Append(j)
+		}
+		b.StartTimer()
+		list.Concat(other)
+	}
+}
+
+func BenchmarkListConcat10(b *testing.B) {
+	benchmarkConcat(b, 10)
+}
+
+func BenchmarkListConcat100000(b *testing.B) {
+	benchmarkConcat(b, 100000)
+}
@@ -0,0 +1,105 @@
+package linkedList
+
+// Sort sorts the list in place according to less, keeping equal elements in
+// their original order. It is a bottom-up merge sort which relinks the nodes
+// rather than moving the elements, so the nodes returned earlier still hold
+// the same elements, and nothing is allocated. Runs in O(n log n)
+func (list *List[T]) Sort(less func(a, b T) bool) {
+	list.sync()
+	if list.size < 2 {
+		return
+	}
+	for width := uint(1); width < list.size; width *= 2 {
+		// Merge the runs of width nodes two by two, relinking each merged
+		// run after the previous one
+		last := list.head
+		for current := list.head.next; current != nil; {
+			left := current
+			right := cutAfter(left, width)
+			current = cutAfter(right, width)
+			last = mergeAfter(last, left, right, less)
+		}
+		list.tail = last
+	}
+	list.debugValidate()
+}
+
+// IsSorted returns whether the elements are in the order defined by less
+func (list *List[T]) IsSorted(less func(a, b T) bool) bool {
+	for current := list.head.next; current != nil && current.next != nil; current = current.next {
+		if less(current.next.data, current.data) {
+			return false
+		}
+	}
+	return true
+}
+
+// InsertSorted inserts the provided data into a list sorted according to less,
+// after the elements equal to it, and returns the pointer to the Node. The
+// order of the list is unspecified afterwards if it was not sorted
+func (list *List[T]) InsertSorted(data T, less func(a, b T) bool) *Node[T] {
+	list.sync()
+	previous := list.head
+	if list.tail == list.head || less(data, list.tail.data) {
+		for previous.next != nil && !less(data, previous.next.data) {
+			previous = previous.next
+		}
+	} else {
+		previous = list.tail
+	}
+	node := list.insertAfter(previous, data)
+	list.debugValidate()
+	return node
+}
+
+// Merge moves the elements of other into the list, both being sorted
+// according to less, so that the list stays sorted. Equal elements of the
+// list come before those of other. The nodes of other are relinked into the
+// list rather than copied, so other is left empty. Runs in O(n + m)
+func (list *List[T]) Merge(other *List[T], less func(a, b T) bool) {
+	if other == list {
+		return
+	}
+	list.sync()
+	other.sync()
+	list.tail = mergeAfter(list.head, list.head.next, other.head.next, less)
+	list.size += other.size
+	list.absorb(other)
+	list.debugValidate()
+}
+
+// cutAfter unlinks the nodes following the first n nodes from node and returns
+// the first of them, nil if there are no more than n nodes
+func cutAfter[T any](node *Node[T], n uint) *Node[T] {
+	for i := uint(1); i < n && node != nil; i++ {
+		node = node.next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	return rest
+}
+
+// mergeAfter merges the sorted nodes from left and right after previous,
+// taking from left first on equal elements, and returns the last node
+func mergeAfter[T any](previous, left, right *Node[T], less func(a, b T) bool) *Node[T] {
+	for left != nil && right != nil {
+		if less(right.data, left.data) {
+			previous.next, right = right, right.next
+		} else {
+			previous.next, left = left, left.next
+		}
+		previous = previous.next
+	}
+	if left != nil {
+		previous.next = left
+	} else {
+		previous.next = right
+	}
+	for previous.next != nil {
+		previous = previous.next
+	}
+	return previous
+}
@@ -0,0 +1,175 @@
+package linkedList
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func lessInt(a, b int) bool { return a < b }
+
+// pair is sorted by key only, seq records the original order
+type pair struct {
+	key, seq int
+}
+
+func lessPair(a, b pair) bool { return a.key < b.key }
+
+func newPairList(keys ...int) *List[pair] {
+	list := New[pair]()
+	for i, key := range keys {
+		list.Append(pair{key, i})
+	}
+	return list
+}
+
+func TestListSort(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := New[int]()
+	list.Sort(lessInt)
+	assert.Equal("[]", list.String())
+
+	list = newIntList(3, 1, 2, 5, 4)
+	node, _ := list.GetNode(0)
+	list.Sort(lessInt)
+	assert.Equal("[1 2 3 4 5]", list.String())
+	assert.True(list.IsSorted(lessInt))
+	tail, _ := list.Tail()
+	assert.Equal(5, tail)
+	assert.Equal(uint(5), list.Size())
+
+	// Nodes are relinked, not rewritten, and stay attached to the list
+	assert.Equal(3, node.Val())
+	node.InsertAfter(9)
+	assert.Equal("[1 2 3 9 4 5]", list.String())
+	assert.Equal(uint(6), list.Size())
+}
+
+func TestListSortStable(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newPairList(2, 1, 2, 0, 1, 2, 0)
+	list.Sort(lessPair)
+	expected := []pair{{0, 3}, {0, 6}, {1, 1}, {1, 4}, {2, 0}, {2, 2}, {2, 5}}
+	assert.Equal(expected, slices.Collect(list.Values()))
+}
+
+func TestListSortRandom(t *testing.T) {
+	assert := assert.New(t)
+
+	r := rand.New(rand.NewSource(42))
+	for _, n := range []int{1, 2, 3, 7, 8, 9, 100, 1023, 1024, 1025, 100000} {
+		keys := make([]int, n)
+		for i := range keys {
+			// Few distinct keys so that stability matters
+			keys[i] = r.Intn(n/4 + 1)
+		}
+		list := newPairList(keys...)
+		expected := slices.Collect(list.Values())
+		slices.SortStableFunc(expected, func(a, b pair) int { return a.key - b.key })
+
+		list.Sort(lessPair)
+		assert.Equal(expected, slices.Collect(list.Values()), "n = %d", n)
+		assert.Nil(list.Validate())
+		tail, _ := list.Tail()
+		assert.Equal(expected[n-1], tail)
+	}
+}
+
+func TestListSortDoesNotAllocate(t *testing.T) {
+	assert := assert.New(t)
+
+	r := rand.New(rand.NewSource(1))
+	list := New[int]()
+	for i := 0; i < 1000; i++ {
+		list.Append(r.Int())
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		list.Sort(func(a, b int) bool { return a%1000 < b%1000 })
+	})
+	assert.Equal(float64(0), allocs)
+}
+
+func TestListIsSorted(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(New[int]().IsSorted(lessInt))
+	assert.True(newIntList(1).IsSorted(lessInt))
+	assert.True(newIntList(1, 1, 2).IsSorted(lessInt))
+	assert.False(newIntList(1, 3, 2).IsSorted(lessInt))
+}
+
+func TestListInsertSorted(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := New[pair]()
+	for i, key := range []int{5, 1, 3, 1, 9, 0, 5} {
+		list.InsertSorted(pair{key, i}, lessPair)
+	}
+	expected := []pair{{0, 5}, {1, 1}, {1, 3}, {3, 2}, {5, 0}, {5, 6}, {9, 4}}
+	assert.Equal(expected, slices.Collect(list.Values()))
+	tail, _ := list.Tail()
+	assert.Equal(pair{9, 4}, tail)
+	assert.Equal(uint(7), list.Size())
+}
+
+func TestListMerge(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	list := New[pair]()
+	list.Append(pair{1, 0})
+	list.Append(pair{3, 1})
+	list.Append(pair{5, 2})
+	other := New[pair]()
+	other.Append(pair{0, 10})
+	other.Append(pair{3, 11})
+	other.Append(pair{6, 12})
+	moved, _ := other.GetNode(1)
+
+	list.Merge(other, lessPair)
+	expected := []pair{{0, 10}, {1, 0}, {3, 1}, {3, 11}, {5, 2}, {6, 12}}
+	assert.Equal(expected, slices.Collect(list.Values()))
+	assert.Equal(uint(6), list.Size())
+	tail, _ := list.Tail()
+	assert.Equal(pair{6, 12}, tail)
+
+	assert.True(other.IsEmpty())
+	assert.Equal(uint(0), other.Size())
+	_, ok := other.Tail()
+	assert.False(ok)
+
+	// Moved nodes now belong to the list
+	moved.InsertAfter(pair{4, 13})
+	assert.Equal(uint(7), list.Size())
+	assert.Equal(uint(0), other.Size())
+
+	list.Merge(New[pair](), lessPair)
+	list.Merge(list, lessPair)
+	assert.Equal(uint(7), list.Size())
+
+	empty := New[int]()
+	empty.Merge(newIntList(1, 2), lessInt)
+	assert.Equal("[1 2]", empty.String())
+	tailInt, _ := empty.Tail()
+	assert.Equal(2, tailInt)
+}
+
+func BenchmarkListSort(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	vals := make([]int, 100000)
+	for i := range vals {
+		vals[i] = r.Int()
+	}
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list := newIntList(vals...)
+		b.StartTimer()
+		list.Sort(lessInt)
+	}
+}
@@ -0,0 +1,90 @@
+package linkedList
+
+import (
+	"fmt"
+)
+
+// Invariants checked by Validate
+const (
+	// INVARIANT_ACYCLIC requires the chain of nodes to end, a node linking back
+	// to an earlier node makes it loop forever
+	INVARIANT_ACYCLIC = "node links back to an earlier node"
+	// INVARIANT_SIZE requires the size kept by the list to match the number of
+	// nodes in the chain
+	INVARIANT_SIZE = "size does not match the number of nodes"
+	// INVARIANT_TAIL requires the tail kept by the list to be the last node of
+	// the chain
+	INVARIANT_TAIL = "tail is not the last node"
+)
+
+// DebugValidation makes every mutation through the methods of a List, and
+// InsertAfter on one of its nodes, validate the list afterwards and panic
+// with the *ValidationError if an invariant is broken. It is meant to be
+// switched on in tests. SetNext is not checked, as relinking nodes by hand
+// goes through invalid states; call Validate once done instead
+var DebugValidation = false
+
+// ValidationError reports the node at which Validate found an invariant to be
+// broken, along with its position in the list. For INVARIANT_ACYCLIC this is
+// the node whose next pointer closes the loop; for INVARIANT_SIZE and
+// INVARIANT_TAIL this is the last node, nil if the chain is empty
+type ValidationError[T any] struct {
+	Node      *Node[T]
+	Pos       uint
+	Invariant string
+}
+
+func (err *ValidationError[T]) Error() string {
+	if err.Node == nil {
+		return fmt.Sprintf("Invalid list: %s", err.Invariant)
+	}
+	return fmt.Sprintf("Invalid list at position %d (%v): %s", err.Pos, err.Node, err.Invariant)
+}
+
+// Validate checks the chain of nodes of the list and the size and tail kept
+// for it, and returns a *ValidationError for the first broken invariant
+// found, nil if the list is consistent
+func (list *List[T]) Validate() error {
+	if cycle := FloydCycle(list.head.next); cycle != nil {
+		return list.cycleError(cycle)
+	}
+
+	if list.dirty {
+		// size and tail are recounted on their next use
+		return nil
+	}
+	var count uint = 0
+	last := list.head
+	for last.next != nil {
+		last = last.next
+		count++
+	}
+	var lastNode *Node[T]
+	var lastPos uint = 0
+	if last != list.head {
+		lastNode, lastPos = last, count-1
+	}
+	if count != list.size {
+		return &ValidationError[T]{lastNode, lastPos, INVARIANT_SIZE}
+	}
+	if last != list.tail {
+		return &ValidationError[T]{lastNode, lastPos, INVARIANT_TAIL}
+	}
+	return nil
+}
+
+// cycleError reports the node closing the loop of the cycle
+func (list *List[T]) cycleError(cycle *Cycle[T]) error {
+	return &ValidationError[T]{cycle.Last, cycle.Pos + cycle.Length - 1, INVARIANT_ACYCLIC}
+}
+
+// debugValidate validates the list when DebugValidation is on, and panics on
+// error
+func (list *List[T]) debugValidate() {
+	if !DebugValidation {
+		return
+	}
+	if err := list.Validate(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,124 @@
+package linkedList
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// enableDebugValidation turns DebugValidation on for the duration of the test
+func enableDebugValidation(t *testing.T) {
+	DebugValidation = true
+	t.Cleanup(func() { DebugValidation = false })
+}
+
+func newIntList(vals ...int) *List[int] {
+	list := New[int]()
+	for _, val := range vals {
+		list.Append(val)
+	}
+	return list
+}
+
+func assertViolation(assert *assert.Assertions, err error, node *Node[int], pos uint, invariant string) {
+	validationErr, ok := err.(*ValidationError[int])
+	if assert.Equal(true, ok, "expected a *ValidationError, got %v", err) {
+		assert.Equal(node, validationErr.Node)
+		assert.Equal(pos, validationErr.Pos)
+		assert.Equal(invariant, validationErr.Invariant)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(New[int]().Validate())
+	list := newIntList(1, 2, 3)
+	assert.Nil(list.Validate())
+
+	// Hand-linked changes are fine as long as the chain ends
+	node, _ := list.GetNode(1)
+	node.SetNext(nil)
+	assert.Nil(list.Validate())
+	assert.Equal(uint(2), list.Size())
+	assert.Nil(list.Validate())
+}
+
+func TestValidateCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newIntList(1, 2, 3, 4, 5)
+	second, _ := list.GetNode(1)
+	last, _ := list.GetNode(4)
+	last.SetNext(second)
+	assertViolation(assert, list.Validate(), last, 4, INVARIANT_ACYCLIC)
+
+	list = newIntList(1)
+	first, _ := list.GetNode(0)
+	first.SetNext(first)
+	assertViolation(assert, list.Validate(), first, 0, INVARIANT_ACYCLIC)
+}
+
+func TestValidateSizeAndTail(t *testing.T) {
+	assert := assert.New(t)
+
+	list := newIntList(1, 2, 3)
+	last, _ := list.GetNode(2)
+	list.size = 4
+	assertViolation(assert, list.Validate(), last, 2, INVARIANT_SIZE)
+
+	list.size = 3
+	list.tail = list.head.next
+	assertViolation(assert, list.Validate(), last, 2, INVARIANT_TAIL)
+
+	list = New[int]()
+	list.size = 1
+	assertViolation(assert, list.Validate(), nil, 0, INVARIANT_SIZE)
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	err := &ValidationError[int]{NewNodeWithVal(7), 3, INVARIANT_ACYCLIC}
+	assert.Equal("Invalid list at position 3 (7): node links back to an earlier node", err.Error())
+
+	err = &ValidationError[int]{nil, 0, INVARIANT_SIZE}
+	assert.Equal("Invalid list: size does not match the number of nodes", err.Error())
+}
+
+func TestDebugValidationLeavesSetNextToValidate(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	// Swapping two nodes by hand goes through a cycle, which is not checked
+	list := newIntList(1, 2, 3, 4)
+	p, _ := list.GetNode(0)
+	a, _ := list.GetNode(1)
+	b, _ := list.GetNode(2)
+	c, _ := list.GetNode(3)
+	p.SetNext(b)
+	b.SetNext(a)
+	a.SetNext(c)
+	assert.Nil(list.Validate())
+	assert.Equal("[1 3 2 4]", list.String())
+
+	// A cycle left behind is reported once Validate is called
+	c.SetNext(p)
+	assertViolation(assert, list.Validate(), c, 3, INVARIANT_ACYCLIC)
+}
+
+func TestDebugValidationMutations(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	// None of these panic as every mutation keeps the list consistent
+	list := newIntList(1, 2, 3)
+	list.Insert(0, 0)
+	list.Insert(4, 4)
+	list.Delete(2)
+	node, _ := list.GetNode(1)
+	node.InsertAfter(2)
+	node.SetNext(nil)
+	list.Append(5)
+	assert.Equal("[0 1 5]", list.String())
+}
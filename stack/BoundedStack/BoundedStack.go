@@ -0,0 +1,160 @@
+package stack
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	sliceStack "github.com/yuhlau/go-data-structures/stack/SliceStack"
+)
+
+// Stack is a stack of elements of type T holding at most a fixed number of
+// elements, safe for concurrent use. Goroutines can wait for room to push or
+// for an element to pop, which makes it a LIFO work queue between producers
+// and consumers.
+//
+// Stack satisfies stack.Stack, whose Push cannot report an error, so Push
+// waits for room: pushing to a full stack that no other goroutine pops from
+// blocks forever. Use TryPush or PushWait when that can happen
+type Stack[T any] struct {
+	mutex    sync.Mutex
+	data     *sliceStack.Stack[T]
+	capacity uint
+	// changed is closed and replaced every time an element is pushed or
+	// popped, to wake up the goroutines waiting for room or for an element
+	changed chan struct{}
+}
+
+// New creates and returns an empty Stack holding at most capacity elements,
+// panics if capacity is 0 as nothing could ever be pushed
+func New[T any](capacity uint) *Stack[T] {
+	if capacity == 0 {
+		panic("New: capacity 0 is lower than 1")
+	}
+	return &Stack[T]{
+		// The array is allocated once at its final size and never shrinks
+		data:     sliceStack.NewWithShrinkPolicy[T](capacity, nil),
+		capacity: capacity,
+		changed:  make(chan struct{}),
+	}
+}
+
+// TryPush inserts an element to the top of stack, error if the stack is full
+func (stack *Stack[T]) TryPush(val T) error {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	if stack.data.Size() >= stack.capacity {
+		return errors.New("Stack is full")
+	}
+	stack.data.Push(val)
+	stack._notify()
+	return nil
+}
+
+// TryPop removes and returns the topmost element from stack, error if the
+// stack is empty
+func (stack *Stack[T]) TryPop() (T, error) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	val, err := stack.data.Pop()
+	if err == nil {
+		stack._notify()
+	}
+	return val, err
+}
+
+// PushWait inserts an element to the top of stack, waiting while the stack is
+// full. Returns the error of the context if it is done before there is room
+func (stack *Stack[T]) PushWait(ctx context.Context, val T) error {
+	for {
+		stack.mutex.Lock()
+		if stack.data.Size() < stack.capacity {
+			stack.data.Push(val)
+			stack._notify()
+			stack.mutex.Unlock()
+			return nil
+		}
+		changed := stack.changed
+		stack.mutex.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// PopWait removes and returns the topmost element from stack, waiting while
+// the stack is empty. Returns the error of the context if it is done before
+// there is an element
+func (stack *Stack[T]) PopWait(ctx context.Context) (T, error) {
+	for {
+		stack.mutex.Lock()
+		if val, err := stack.data.Pop(); err == nil {
+			stack._notify()
+			stack.mutex.Unlock()
+			return val, nil
+		}
+		changed := stack.changed
+		stack.mutex.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// Push inserts an element to the top of stack, waiting as long as it takes
+// for room if the stack is full, which is forever if nothing pops
+func (stack *Stack[T]) Push(val T) {
+	stack.PushWait(context.Background(), val)
+}
+
+// Pop removes and returns the topmost element from stack without waiting,
+// error if the stack is empty. It is the same as TryPop
+func (stack *Stack[T]) Pop() (T, error) {
+	return stack.TryPop()
+}
+
+// Top returns the topmost element from stack, error if the stack is empty
+func (stack *Stack[T]) Top() (T, error) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	return stack.data.Top()
+}
+
+// IsEmpty returns whether the stack is empty
+func (stack *Stack[T]) IsEmpty() bool {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	return stack.data.IsEmpty()
+}
+
+// IsFull returns whether the stack holds as many elements as its capacity
+func (stack *Stack[T]) IsFull() bool {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	return stack.data.Size() >= stack.capacity
+}
+
+// Size returns the number of elements in the stack
+func (stack *Stack[T]) Size() uint {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	return stack.data.Size()
+}
+
+// Cap returns the maximum number of elements the stack can hold
+func (stack *Stack[T]) Cap() uint {
+	return stack.capacity
+}
+
+// _notify wakes up the waiting goroutines, the mutex must be held
+func (stack *Stack[T]) _notify() {
+	close(stack.changed)
+	stack.changed = make(chan struct{})
+}
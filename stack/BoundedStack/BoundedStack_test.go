@@ -0,0 +1,208 @@
+package stack
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yuhlau/go-data-structures/stack"
+	"github.com/yuhlau/go-data-structures/stack/stackTest"
+)
+
+func TestConformance(t *testing.T) {
+	stackTest.Run(t, func() stack.Stack { return New[interface{}](100000) })
+}
+
+// tryPushStack pushes through TryPush, so that a push to a full stack fails
+// the test instead of blocking it
+type tryPushStack struct {
+	*Stack[interface{}]
+	t *testing.T
+}
+
+func (s tryPushStack) Push(val interface{}) {
+	if err := s.TryPush(val); err != nil {
+		s.t.Errorf("Push %v: %v", val, err)
+	}
+}
+
+// TestConformanceSmallCapacity runs the conformance test cases, which hold at
+// most 3 elements at a time, against a stack that is full at 3
+func TestConformanceSmallCapacity(t *testing.T) {
+	for _, testCase := range stackTest.TestCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			stackTest.RunOps(t, tryPushStack{New[interface{}](3), t}, testCase.Ops)
+		})
+	}
+	t.Run("full", func(t *testing.T) {
+		assert := assert.New(t)
+
+		s := New[interface{}](3)
+		stackTest.RunOps(t, tryPushStack{s, t}, []stackTest.Op{
+			stackTest.Push(1), stackTest.Push(2), stackTest.Push(3),
+		})
+		assert.Equal("Stack is full", s.TryPush(4).Error())
+		assert.Equal(uint(3), s.Size())
+		val, err := s.Top()
+		assert.Equal(3, val)
+		assert.Nil(err)
+	})
+}
+
+func TestNewZeroCapacityPanics(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Panics(func() { New[int](0) })
+}
+
+func TestTryPushTryPop(t *testing.T) {
+	assert := assert.New(t)
+
+	s := New[int](2)
+	val, err := s.TryPop()
+	assert.Equal(0, val)
+	assert.Equal("Stack is empty", err.Error())
+
+	assert.Nil(s.TryPush(1))
+	assert.Nil(s.TryPush(2))
+	assert.Equal(true, s.IsFull())
+	assert.Equal("Stack is full", s.TryPush(3).Error())
+	assert.Equal(uint(2), s.Size())
+	assert.Equal(uint(2), s.Cap())
+
+	val, err = s.TryPop()
+	assert.Equal(2, val)
+	assert.Nil(err)
+	assert.Equal(false, s.IsFull())
+}
+
+func TestPopWaitBlocksUntilPush(t *testing.T) {
+	assert := assert.New(t)
+
+	s := New[int](1)
+	result := make(chan int)
+	go func() {
+		val, err := s.PopWait(context.Background())
+		assert.Nil(err)
+		result <- val
+	}()
+
+	select {
+	case <-result:
+		assert.Fail("PopWait returned from an empty stack")
+	case <-time.After(20 * time.Millisecond):
+	}
+	s.Push(7)
+	assert.Equal(7, <-result)
+	assert.Equal(true, s.IsEmpty())
+}
+
+func TestPushWaitBlocksUntilPop(t *testing.T) {
+	assert := assert.New(t)
+
+	s := New[int](1)
+	s.Push(1)
+	done := make(chan error)
+	go func() {
+		done <- s.PushWait(context.Background(), 2)
+	}()
+
+	select {
+	case <-done:
+		assert.Fail("PushWait returned on a full stack")
+	case <-time.After(20 * time.Millisecond):
+	}
+	val, _ := s.Pop()
+	assert.Equal(1, val)
+	assert.Nil(<-done)
+	val, _ = s.Top()
+	assert.Equal(2, val)
+}
+
+func TestWaitDeadline(t *testing.T) {
+	assert := assert.New(t)
+
+	s := New[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	val, err := s.PopWait(ctx)
+	assert.Equal(0, val)
+	assert.Equal(context.DeadlineExceeded, err)
+
+	s.Push(1)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(context.DeadlineExceeded, s.PushWait(ctx, 2))
+	assert.Equal(uint(1), s.Size())
+}
+
+func TestWaitCancel(t *testing.T) {
+	assert := assert.New(t)
+
+	s := New[int](1)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := s.PopWait(ctx)
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	assert.Equal(context.Canceled, <-done)
+
+	// A cancelled context still lets the operation through when it can
+	// complete without waiting
+	assert.Nil(s.PushWait(ctx, 1))
+	val, err := s.PopWait(ctx)
+	assert.Equal(1, val)
+	assert.Nil(err)
+}
+
+func TestWorkQueue(t *testing.T) {
+	assert := assert.New(t)
+
+	const producers, consumers, jobs = 4, 4, 1000
+	s := New[int](8)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var produced sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func(p int) {
+			defer produced.Done()
+			for i := 0; i < jobs; i++ {
+				assert.Nil(s.PushWait(ctx, p*jobs+i))
+			}
+		}(p)
+	}
+
+	results := make(chan int, producers*jobs)
+	var consumed sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for {
+				val, err := s.PopWait(ctx)
+				if err != nil {
+					return
+				}
+				assert.Equal(true, s.Size() <= s.Cap())
+				results <- val
+			}
+		}()
+	}
+
+	produced.Wait()
+	seen := make([]bool, producers*jobs)
+	for i := 0; i < producers*jobs; i++ {
+		val := <-results
+		assert.Equal(false, seen[val])
+		seen[val] = true
+	}
+	cancel()
+	consumed.Wait()
+	assert.Equal(true, s.IsEmpty())
+}
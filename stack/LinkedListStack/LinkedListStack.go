@@ -2,55 +2,105 @@ package stack
 
 import (
 	"errors"
-	. "github.com/yuhlau/go-data-structures/linkedList"
+	"iter"
+	"slices"
+
+	"github.com/yuhlau/go-data-structures/linkedList"
 )
 
-type LinkedListStack struct {
-	data *LinkedList
+// Stack is a stack of elements of type T backed by a linked list
+type Stack[T any] struct {
+	data *linkedList.List[T]
 }
 
-// Create and return a new ListedList Stack
-func NewLinkedListStack() *LinkedListStack {
-	return &LinkedListStack{NewLinkedList()}
+// New creates and returns a new Stack of elements of type T
+func New[T any]() *Stack[T] {
+	return &Stack[T]{linkedList.New[T]()}
 }
 
 // Push inserts an element to the top of stack
-func (stack *LinkedListStack) Push(val interface{}) {
+func (stack *Stack[T]) Push(val T) {
 	stack.data.Insert(0, val)
 }
 
 // Pop removes and returns the topmost element from stack, error if the stack
 // is empty
-func (stack *LinkedListStack) Pop() (interface{}, error) {
+func (stack *Stack[T]) Pop() (T, error) {
 	if stack.IsEmpty() {
-		return nil, errors.New("Stack is empty")
-	}
-	val, err := stack.data.Delete(0)
-	if err != nil {
-		return nil, err
+		var zero T
+		return zero, errors.New("Stack is empty")
 	}
-	return val, nil
+	return stack.data.Delete(0)
 }
 
 // Top returns the topmost element from stack, error if the stack is empty
-func (stack *LinkedListStack) Top() (interface{}, error) {
+func (stack *Stack[T]) Top() (T, error) {
 	if stack.IsEmpty() {
-		return nil, errors.New("Stack is empty")
+		var zero T
+		return zero, errors.New("Stack is empty")
 	}
-	val, err := stack.data.Get(0)
-	if err != nil {
-		return nil, err
-	}
-	return val, nil
+	return stack.data.Get(0)
 }
 
-// IsEmpty returns whether the stack is empty
-func (stack *LinkedListStack) Size() uint {
+// Size returns the number of elements in the stack
+func (stack *Stack[T]) Size() uint {
 	return stack.data.Size()
 }
 
-// Size returns the number of elements in the stack
-func (stack *LinkedListStack) IsEmpty() bool {
-	_, notEmpty := stack.data.Head()
-	return !notEmpty
+// IsEmpty returns whether the stack is empty
+func (stack *Stack[T]) IsEmpty() bool {
+	return stack.data.IsEmpty()
+}
+
+// All returns an iterator over the elements of the stack from the top to the
+// bottom, paired with their depth where the topmost element has depth 0
+func (stack *Stack[T]) All() iter.Seq2[int, T] {
+	return stack.data.All()
+}
+
+// Peek returns the element n positions below the top, Peek(0) being the
+// topmost element, error if the stack has no more than n elements
+func (stack *Stack[T]) Peek(n uint) (T, error) {
+	return stack.data.Get(n)
+}
+
+// ToSlice returns the elements of the stack from the top to the bottom
+func (stack *Stack[T]) ToSlice() []T {
+	return slices.AppendSeq(make([]T, 0, stack.Size()), stack.data.Values())
+}
+
+// PushAll pushes the elements in order, the last one ends up on top
+func (stack *Stack[T]) PushAll(vals ...T) {
+	for _, val := range vals {
+		stack.data.Insert(0, val)
+	}
+}
+
+// PopN removes the n topmost elements and returns them from the top down,
+// error if the stack has fewer than n elements in which case nothing is
+// removed
+func (stack *Stack[T]) PopN(n uint) ([]T, error) {
+	if n > stack.Size() {
+		return nil, errors.New("Stack has fewer elements than requested")
+	}
+	return stack.data.DeleteRange(0, n)
+}
+
+// Clear removes all the elements from the stack
+func (stack *Stack[T]) Clear() {
+	stack.data = linkedList.New[T]()
+}
+
+// Clone returns a new Stack holding the same elements in the same order
+func (stack *Stack[T]) Clone() *Stack[T] {
+	clone := New[T]()
+	for val := range stack.data.Values() {
+		clone.data.Append(val)
+	}
+	return clone
+}
+
+// String returns the elements from the top to the bottom, e.g. "[3 2 1]"
+func (stack *Stack[T]) String() string {
+	return stack.data.String()
 }
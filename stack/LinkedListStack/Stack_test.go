@@ -0,0 +1,24 @@
+package stack
+
+import (
+	"testing"
+
+	"github.com/yuhlau/go-data-structures/stack"
+	"github.com/yuhlau/go-data-structures/stack/stackTest"
+)
+
+func TestTyped(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		stackTest.RunTyped(t, func() stack.TypedStack[int] { return New[int]() }, []int{1, 2, 3})
+	})
+	t.Run("struct", func(t *testing.T) {
+		type point struct{ x, y int }
+		stackTest.RunTyped(t, func() stack.TypedStack[point] { return New[point]() }, []point{{1, 2}, {3, 4}})
+	})
+}
+
+func BenchmarkPushPop(b *testing.B) {
+	stackTest.BenchmarkPushPop(b,
+		func() stack.Stack { return NewLinkedListStack() },
+		func() stack.TypedStack[int] { return New[int]() })
+}
@@ -0,0 +1,9 @@
+package stack
+
+// LinkedListStack is the Stack holding interface{} elements
+type LinkedListStack = Stack[interface{}]
+
+// Create and return a new ListedList Stack
+func NewLinkedListStack() *LinkedListStack {
+	return New[interface{}]()
+}
@@ -0,0 +1,75 @@
+package stack
+
+import (
+	sliceStack "github.com/yuhlau/go-data-structures/stack/SliceStack"
+)
+
+// Stack is a stack of elements of type T which knows its minimum and maximum
+// elements in O(1). Every entry remembers the extrema of the elements from
+// itself down to the bottom, so popping restores the previous extrema
+type Stack[T any] struct {
+	entries *sliceStack.Stack[entry[T]]
+	less    func(a, b T) bool
+}
+
+type entry[T any] struct {
+	val T
+	min T
+	max T
+}
+
+// New creates and returns an empty Stack ordering its elements with less,
+// which reports whether a is strictly smaller than b
+func New[T any](less func(a, b T) bool) *Stack[T] {
+	return &Stack[T]{sliceStack.New[entry[T]](), less}
+}
+
+// Push inserts an element to the top of stack
+func (stack *Stack[T]) Push(val T) {
+	e := entry[T]{val, val, val}
+	if top, err := stack.entries.Top(); err == nil {
+		// On ties the extremum pushed first is kept
+		if !stack.less(val, top.min) {
+			e.min = top.min
+		}
+		if !stack.less(top.max, val) {
+			e.max = top.max
+		}
+	}
+	stack.entries.Push(e)
+}
+
+// Pop removes and returns the topmost element from stack, error if the stack
+// is empty
+func (stack *Stack[T]) Pop() (T, error) {
+	e, err := stack.entries.Pop()
+	return e.val, err
+}
+
+// Top returns the topmost element from stack, error if the stack is empty
+func (stack *Stack[T]) Top() (T, error) {
+	e, err := stack.entries.Top()
+	return e.val, err
+}
+
+// Min returns the smallest element in the stack, error if the stack is empty
+func (stack *Stack[T]) Min() (T, error) {
+	e, err := stack.entries.Top()
+	return e.min, err
+}
+
+// Max returns the largest element in the stack, error if the stack is empty
+func (stack *Stack[T]) Max() (T, error) {
+	e, err := stack.entries.Top()
+	return e.max, err
+}
+
+// IsEmpty returns whether the stack is empty
+func (stack *Stack[T]) IsEmpty() bool {
+	return stack.entries.IsEmpty()
+}
+
+// Size returns the number of elements in the stack
+func (stack *Stack[T]) Size() uint {
+	return stack.entries.Size()
+}
@@ -0,0 +1,102 @@
+package stack
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yuhlau/go-data-structures/stack"
+	"github.com/yuhlau/go-data-structures/stack/stackTest"
+)
+
+func lessInt(a, b int) bool { return a < b }
+
+func TestConformance(t *testing.T) {
+	less := func(a, b interface{}) bool { return fmt.Sprint(a) < fmt.Sprint(b) }
+	stackTest.Run(t, func() stack.Stack { return New[interface{}](less) })
+}
+
+func TestMinMax(t *testing.T) {
+	assert := assert.New(t)
+
+	s := New[int](lessInt)
+	val, err := s.Min()
+	assert.Equal(0, val)
+	assert.Equal("Stack is empty", err.Error())
+	_, err = s.Max()
+	assert.Equal("Stack is empty", err.Error())
+
+	for _, val := range []int{5, 3, 8, 3, 1} {
+		s.Push(val)
+	}
+	min, _ := s.Min()
+	max, _ := s.Max()
+	assert.Equal(1, min)
+	assert.Equal(8, max)
+
+	s.Pop() // [5 3 8 3]
+	min, _ = s.Min()
+	assert.Equal(3, min)
+	s.Pop()
+	s.Pop() // [5 3]
+	min, _ = s.Min()
+	max, _ = s.Max()
+	assert.Equal(3, min)
+	assert.Equal(5, max)
+}
+
+func TestMinMaxKeepsFirstOnTies(t *testing.T) {
+	assert := assert.New(t)
+
+	type item struct {
+		key  int
+		name string
+	}
+	s := New[item](func(a, b item) bool { return a.key < b.key })
+	s.Push(item{1, "a"})
+	s.Push(item{1, "b"})
+	min, _ := s.Min()
+	max, _ := s.Max()
+	assert.Equal("a", min.name)
+	assert.Equal("a", max.name)
+}
+
+func TestMinMaxAgainstBruteForce(t *testing.T) {
+	assert := assert.New(t)
+
+	r := rand.New(rand.NewSource(1))
+	s := New[int](lessInt)
+	model := []int{}
+	for i := 0; i < 10000; i++ {
+		if len(model) > 0 && r.Intn(5) < 2 {
+			val, err := s.Pop()
+			assert.Nil(err)
+			assert.Equal(model[len(model)-1], val)
+			model = model[:len(model)-1]
+		} else {
+			val := r.Intn(100) - 50
+			s.Push(val)
+			model = append(model, val)
+		}
+
+		assert.Equal(uint(len(model)), s.Size())
+		if len(model) == 0 {
+			assert.Equal(true, s.IsEmpty())
+			continue
+		}
+		min, max := model[0], model[0]
+		for _, val := range model {
+			if val < min {
+				min = val
+			}
+			if val > max {
+				max = val
+			}
+		}
+		gotMin, _ := s.Min()
+		gotMax, _ := s.Max()
+		assert.Equal(min, gotMin)
+		assert.Equal(max, gotMax)
+	}
+}
@@ -0,0 +1,65 @@
+package stack
+
+import (
+	"errors"
+	"iter"
+
+	"github.com/yuhlau/go-data-structures/linkedList"
+)
+
+// PStack is a persistent stack of elements of type T backed by a PList. Push
+// and Pop return a new version of the stack sharing the elements below the
+// top, the receiver is left untouched. A PStack is therefore safe to read from
+// several goroutines without locking. The zero value is an empty stack
+type PStack[T any] struct {
+	data *linkedList.PList[T]
+}
+
+// New creates and returns an empty PStack of elements of type T
+func New[T any]() PStack[T] {
+	return PStack[T]{}
+}
+
+// Push returns a new version of the stack with the element on top
+func (stack PStack[T]) Push(val T) PStack[T] {
+	return PStack[T]{stack.data.Cons(val)}
+}
+
+// Pop returns a new version of the stack without its topmost element together
+// with that element, error if the stack is empty
+func (stack PStack[T]) Pop() (PStack[T], T, error) {
+	val, ok := stack.data.Head()
+	if !ok {
+		return stack, val, errors.New("Stack is empty")
+	}
+	return PStack[T]{stack.data.Tail()}, val, nil
+}
+
+// Top returns the topmost element from stack, error if the stack is empty
+func (stack PStack[T]) Top() (T, error) {
+	val, ok := stack.data.Head()
+	if !ok {
+		return val, errors.New("Stack is empty")
+	}
+	return val, nil
+}
+
+// Size returns the number of elements in the stack
+func (stack PStack[T]) Size() uint {
+	return stack.data.Size()
+}
+
+// IsEmpty returns whether the stack is empty
+func (stack PStack[T]) IsEmpty() bool {
+	return stack.data.IsEmpty()
+}
+
+// All returns an iterator over the elements of the stack from the top to the
+// bottom, paired with their depth where the topmost element has depth 0
+func (stack PStack[T]) All() iter.Seq2[int, T] {
+	return stack.data.All()
+}
+
+func (stack PStack[T]) String() string {
+	return stack.data.String()
+}
@@ -0,0 +1,71 @@
+package stack
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushPop(t *testing.T) {
+	assert := assert.New(t)
+
+	var s PStack[int]
+	assert.True(s.IsEmpty())
+	_, err := s.Top()
+	assert.Equal("Stack is empty", err.Error())
+	_, _, err = s.Pop()
+	assert.Equal("Stack is empty", err.Error())
+
+	s = New[int]().Push(1).Push(2).Push(3)
+	assert.Equal(uint(3), s.Size())
+	top, _ := s.Top()
+	assert.Equal(3, top)
+
+	s, val, err := s.Pop()
+	assert.Nil(err)
+	assert.Equal(3, val)
+	assert.Equal("[2 1]", s.String())
+}
+
+func TestVersions(t *testing.T) {
+	assert := assert.New(t)
+
+	v1 := New[string]().Push("a")
+	v2 := v1.Push("b")
+	v3, _, _ := v2.Pop()
+	v4 := v3.Push("c")
+
+	assert.Equal("[a]", v1.String())
+	assert.Equal("[b a]", v2.String())
+	assert.Equal("[a]", v3.String())
+	assert.Equal("[c a]", v4.String())
+	assert.Equal(uint(2), v2.Size())
+}
+
+func TestConcurrentReaders(t *testing.T) {
+	assert := assert.New(t)
+
+	s := New[int]()
+	for i := 0; i < 100; i++ {
+		s = s.Push(i)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each reader pops its own versions of the same snapshot
+			current := s
+			for i := 99; i >= 0; i-- {
+				var val int
+				current, val, _ = current.Pop()
+				assert.Equal(i, val)
+			}
+			assert.True(current.IsEmpty())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(uint(100), s.Size())
+}
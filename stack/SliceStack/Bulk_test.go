@@ -0,0 +1,25 @@
+package stack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yuhlau/go-data-structures/stack/stackTest"
+)
+
+func TestBulk(t *testing.T) {
+	stackTest.RunBulk(t, New[int])
+}
+
+func TestPopNReleasesMemory(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewWithDefaultCap[int](4)
+	s.PushAll(make([]int, 64)...)
+	s.PopN(60)
+	assert.Equal(true, s.Cap() < 64)
+
+	s.Clear()
+	assert.Equal(uint(4), s.Cap())
+	assert.Equal(uint(4), s.Clone().Cap())
+}
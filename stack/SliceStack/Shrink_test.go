@@ -0,0 +1,202 @@
+package stack
+
+import (
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopClearsSlot(t *testing.T) {
+	assert := assert.New(t)
+
+	stack := NewSliceStackWithShrinkPolicy(4, nil)
+	stack.Push(1)
+	stack.Push(2)
+	stack.Pop()
+	// The slot past the top still belongs to the underlying array
+	assert.Nil(stack.data[:2][1])
+}
+
+func TestShrinkHalfAtQuarter(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(64, ShrinkHalfAtQuarter(17, 64))
+	assert.Equal(32, ShrinkHalfAtQuarter(16, 64))
+	assert.Equal(4, ShrinkHalfAtQuarter(0, 8))
+}
+
+// assertPanicsWith checks that fn panics with the message
+func assertPanicsWith(assert *assert.Assertions, message string, fn func()) {
+	defer func() {
+		assert.Equal(message, recover())
+	}()
+	fn()
+}
+
+func TestShrinkWithHysteresis(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := ShrinkWithHysteresis(0.125, 4)
+	assert.Equal(64, policy(9, 64))
+	assert.Equal(16, policy(8, 64))
+
+	assertPanicsWith(assert, "ShrinkWithHysteresis: factor 0 is lower than 2", func() {
+		ShrinkWithHysteresis(0.5, 0)
+	})
+	assertPanicsWith(assert, "ShrinkWithHysteresis: factor 1 is lower than 2", func() {
+		ShrinkWithHysteresis(0.5, 1)
+	})
+	assertPanicsWith(assert, "ShrinkWithHysteresis: ratio 0.5 is not between 0 and 1/2", func() {
+		ShrinkWithHysteresis(0.5, 2)
+	})
+	assertPanicsWith(assert, "ShrinkWithHysteresis: ratio 0 is not between 0 and 1/4", func() {
+		ShrinkWithHysteresis(0, 4)
+	})
+}
+
+func TestDefaultShrinkPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	stack := NewWithDefaultCap[int](8)
+	for i := 0; i < 1024; i++ {
+		stack.Push(i)
+	}
+	grown := stack.Cap()
+	assert.Equal(true, grown >= 1024)
+
+	for stack.Size() > 1 {
+		stack.Pop()
+		// The array is at most about four times larger than needed
+		assert.Equal(true, stack.Cap() <= 4*stack.Size()+8, "size %d cap %d", stack.Size(), stack.Cap())
+	}
+	stack.Pop()
+	// The default capacity is kept
+	assert.Equal(uint(8), stack.Cap())
+
+	// Elements survive the reallocations
+	for i := 0; i < 100; i++ {
+		stack.Push(i)
+	}
+	for i := 99; i >= 0; i-- {
+		val, _ := stack.Pop()
+		assert.Equal(i, val)
+	}
+}
+
+func TestNoShrinkPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	stack := NewWithShrinkPolicy[int](8, nil)
+	for i := 0; i < 1024; i++ {
+		stack.Push(i)
+	}
+	grown := stack.Cap()
+	for !stack.IsEmpty() {
+		stack.Pop()
+	}
+	assert.Equal(grown, stack.Cap())
+
+	stack.SetShrinkPolicy(ShrinkHalfAtQuarter)
+	stack.Push(1)
+	stack.Pop()
+	assert.Equal(grown/2, stack.Cap())
+}
+
+func TestClip(t *testing.T) {
+	assert := assert.New(t)
+
+	stack := NewWithShrinkPolicy[int](100, nil)
+	stack.Push(1)
+	stack.Push(2)
+	stack.Clip()
+	assert.Equal(uint(2), stack.Cap())
+	val, _ := stack.Top()
+	assert.Equal(2, val)
+
+	stack.Pop()
+	stack.Pop()
+	stack.Clip()
+	assert.Equal(uint(0), stack.Cap())
+	stack.Push(3)
+	assert.Equal(uint(1), stack.Size())
+}
+
+func TestReserve(t *testing.T) {
+	assert := assert.New(t)
+
+	stack := NewWithDefaultCap[int](4)
+	stack.Push(1)
+	stack.Reserve(100)
+	assert.Equal(uint(101), stack.Cap())
+
+	// Reserving less than what is free does nothing
+	stack.Reserve(10)
+	assert.Equal(uint(101), stack.Cap())
+
+	data := &stack.data[0]
+	for i := 0; i < 100; i++ {
+		stack.Push(i)
+	}
+	// No reallocation happened
+	assert.Equal(data, &stack.data[0])
+}
+
+// waitFor runs the garbage collector until the condition holds, or gives up
+// after a second
+func waitFor(condition func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		runtime.GC()
+		if condition() {
+			return true
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return condition()
+}
+
+func TestPoppedElementsAreCollected(t *testing.T) {
+	assert := assert.New(t)
+
+	type payload struct{ buf [64]byte }
+	var finalized int32
+
+	stack := NewWithShrinkPolicy[*payload](16, nil)
+	for i := 0; i < 10; i++ {
+		p := &payload{}
+		runtime.SetFinalizer(p, func(*payload) { atomic.AddInt32(&finalized, 1) })
+		stack.Push(p)
+	}
+	for i := 0; i < 8; i++ {
+		stack.Pop()
+	}
+
+	assert.Equal(true, waitFor(func() bool { return atomic.LoadInt32(&finalized) == 8 }))
+	// The elements still in the stack are kept alive
+	assert.Equal(int32(8), atomic.LoadInt32(&finalized))
+	assert.Equal(uint(2), stack.Size())
+	runtime.KeepAlive(stack)
+}
+
+func TestShrunkArrayIsCollected(t *testing.T) {
+	assert := assert.New(t)
+
+	stack := NewWithDefaultCap[int](8)
+	for i := 0; i < 4096; i++ {
+		stack.Push(i)
+	}
+	// Watch the array the elements were grown into
+	var finalized int32
+	runtime.SetFinalizer(&stack.data[:cap(stack.data)][0], func(*int) {
+		atomic.AddInt32(&finalized, 1)
+	})
+	for !stack.IsEmpty() {
+		stack.Pop()
+	}
+
+	assert.Equal(true, waitFor(func() bool { return atomic.LoadInt32(&finalized) == 1 }))
+	runtime.KeepAlive(stack)
+}
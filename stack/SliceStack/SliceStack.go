@@ -2,64 +2,253 @@ package stack
 
 import (
 	"errors"
+	"fmt"
+	"iter"
+	"strings"
 )
 
 const (
 	SLICESTACK_DEFAULT_CAP = 30
 )
 
-type SliceStack struct {
-	data []interface{}
+// Stack is a stack of elements of type T backed by a slice
+type Stack[T any] struct {
+	data []T
 	top  int
+	// minCap is the capacity the underlying array never shrinks below
+	minCap int
+	shrink ShrinkPolicy
 }
 
-// Create and return a new Array Stack with the default capacity of the
-// underlying array.
-func NewSliceStack() *SliceStack {
-	return NewSliceStackWithDefaultCap(SLICESTACK_DEFAULT_CAP)
+// ShrinkPolicy decides, after each Pop, the capacity the underlying array of
+// a stack holding size elements in an array of the capacity should shrink
+// to. Returning the capacity itself, or anything smaller than size, keeps the
+// array as it is
+type ShrinkPolicy func(size, capacity int) int
+
+// ShrinkHalfAtQuarter halves the capacity once the stack is a quarter full.
+// Growing doubles the capacity, so the stack has to lose half of its
+// elements before it gets reallocated again, and pushes and pops alternating
+// around a boundary never thrash. It is the policy used by default
+func ShrinkHalfAtQuarter(size, capacity int) int {
+	if size <= capacity/4 {
+		return capacity / 2
+	}
+	return capacity
+}
+
+// ShrinkWithHysteresis returns a policy which divides the capacity by factor
+// once the stack holds no more than the ratio of its capacity. The ratio must
+// be lower than 1/factor for the stack to stay below the new capacity after
+// shrinking. Panics if factor is lower than 2 or ratio is not between 0 and
+// 1/factor
+func ShrinkWithHysteresis(ratio float64, factor int) ShrinkPolicy {
+	if factor < 2 {
+		panic(fmt.Sprintf("ShrinkWithHysteresis: factor %d is lower than 2", factor))
+	}
+	if ratio <= 0 || ratio >= 1/float64(factor) {
+		panic(fmt.Sprintf("ShrinkWithHysteresis: ratio %v is not between 0 and 1/%d", ratio, factor))
+	}
+	return func(size, capacity int) int {
+		if float64(size) <= float64(capacity)*ratio {
+			return capacity / factor
+		}
+		return capacity
+	}
 }
 
-// Create and return a new Array Stack with specified default capacity of the
-// underlying array. The default capacity is just for initialization and the
-// array will expands itself when the number of elements in stack exceed the
-// size
-func NewSliceStackWithDefaultCap(defaultCap uint) *SliceStack {
-	data := make([]interface{}, 0, defaultCap)
-	return &SliceStack{data, -1}
+// New creates and returns a new Stack of elements of type T with the default
+// capacity of the underlying array
+func New[T any]() *Stack[T] {
+	return NewWithDefaultCap[T](SLICESTACK_DEFAULT_CAP)
+}
+
+// NewWithDefaultCap creates and returns a new Stack of elements of type T
+// with specified default capacity of the underlying array. The default
+// capacity is just for initialization and the array will expands itself when
+// the number of elements in stack exceed the size
+func NewWithDefaultCap[T any](defaultCap uint) *Stack[T] {
+	return NewWithShrinkPolicy[T](defaultCap, ShrinkHalfAtQuarter)
+}
+
+// NewWithShrinkPolicy creates and returns a new Stack of elements of type T
+// with specified default capacity of the underlying array, which releases
+// memory after Pop as decided by the policy, never below the default
+// capacity. A nil policy never shrinks the array
+func NewWithShrinkPolicy[T any](defaultCap uint, policy ShrinkPolicy) *Stack[T] {
+	data := make([]T, 0, defaultCap)
+	return &Stack[T]{data: data, top: -1, minCap: int(defaultCap), shrink: policy}
 }
 
 // Push inserts an element to the top of stack
-func (stack *SliceStack) Push(val interface{}) {
+func (stack *Stack[T]) Push(val T) {
 	stack.data = append(stack.data, val)
 	stack.top++
 }
 
 // Pop removes and returns the topmost element from stack, error if the stack
 // is empty
-func (stack *SliceStack) Pop() (interface{}, error) {
+func (stack *Stack[T]) Pop() (T, error) {
 	if stack.IsEmpty() {
-		return nil, errors.New("Stack is empty")
+		var zero T
+		return zero, errors.New("Stack is empty")
 	}
 	data := stack.data[stack.top]
+	// Clear the slot so that whatever it references can be garbage collected
+	var zero T
+	stack.data[stack.top] = zero
 	stack.data = stack.data[:stack.top]
 	stack.top--
+	stack._shrink()
 	return data, nil
 }
 
+// SetShrinkPolicy replaces the policy deciding when the underlying array
+// shrinks after Pop, nil never shrinks it
+func (stack *Stack[T]) SetShrinkPolicy(policy ShrinkPolicy) {
+	stack.shrink = policy
+}
+
+// Clip shrinks the underlying array to exactly fit the elements in the stack,
+// releasing the rest of the memory
+func (stack *Stack[T]) Clip() {
+	stack._resize(len(stack.data))
+}
+
+// Reserve grows the underlying array if needed so that n more elements can be
+// pushed without reallocating. The capacity reserved may be shrunk again by
+// the shrink policy once elements are popped
+func (stack *Stack[T]) Reserve(n uint) {
+	if need := len(stack.data) + int(n); need > cap(stack.data) {
+		stack._resize(need)
+	}
+}
+
+// Cap returns the capacity of the underlying array
+func (stack *Stack[T]) Cap() uint {
+	return uint(cap(stack.data))
+}
+
+func (stack *Stack[T]) _shrink() {
+	if stack.shrink == nil {
+		return
+	}
+	size, capacity := len(stack.data), cap(stack.data)
+	newCap := stack.shrink(size, capacity)
+	if newCap < stack.minCap {
+		newCap = stack.minCap
+	}
+	if newCap < capacity && newCap >= size {
+		stack._resize(newCap)
+	}
+}
+
+// _resize moves the elements to a new underlying array of the capacity
+func (stack *Stack[T]) _resize(capacity int) {
+	data := make([]T, len(stack.data), capacity)
+	copy(data, stack.data)
+	stack.data = data
+}
+
 // Top returns the topmost element from stack, error if the stack is empty
-func (stack *SliceStack) Top() (interface{}, error) {
+func (stack *Stack[T]) Top() (T, error) {
 	if stack.IsEmpty() {
-		return nil, errors.New("Stack is empty")
+		var zero T
+		return zero, errors.New("Stack is empty")
 	}
 	return stack.data[stack.top], nil
 }
 
 // IsEmpty returns whether the stack is empty
-func (stack *SliceStack) IsEmpty() bool {
+func (stack *Stack[T]) IsEmpty() bool {
 	return stack.top == -1
 }
 
 // Size returns the number of elements in the stack
-func (stack *SliceStack) Size() uint {
+func (stack *Stack[T]) Size() uint {
 	return uint(stack.top + 1)
 }
+
+// All returns an iterator over the elements of the stack from the top to the
+// bottom, paired with their depth where the topmost element has depth 0
+func (stack *Stack[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := stack.top; i >= 0; i-- {
+			if !yield(stack.top-i, stack.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Peek returns the element n positions below the top, Peek(0) being the
+// topmost element, error if the stack has no more than n elements
+func (stack *Stack[T]) Peek(n uint) (T, error) {
+	if n >= stack.Size() {
+		var zero T
+		return zero, errors.New("Invalid position")
+	}
+	return stack.data[stack.top-int(n)], nil
+}
+
+// ToSlice returns the elements of the stack from the top to the bottom
+func (stack *Stack[T]) ToSlice() []T {
+	vals := make([]T, 0, stack.Size())
+	for i := stack.top; i >= 0; i-- {
+		vals = append(vals, stack.data[i])
+	}
+	return vals
+}
+
+// PushAll pushes the elements in order, the last one ends up on top. The
+// underlying array grows at most once
+func (stack *Stack[T]) PushAll(vals ...T) {
+	stack.data = append(stack.data, vals...)
+	stack.top += len(vals)
+}
+
+// PopN removes the n topmost elements and returns them from the top down,
+// error if the stack has fewer than n elements in which case nothing is
+// removed
+func (stack *Stack[T]) PopN(n uint) ([]T, error) {
+	if n > stack.Size() {
+		return nil, errors.New("Stack has fewer elements than requested")
+	}
+	bottom := len(stack.data) - int(n)
+	vals := make([]T, 0, n)
+	for i := stack.top; i >= bottom; i-- {
+		vals = append(vals, stack.data[i])
+	}
+	// Clear the slots so that whatever they reference can be garbage collected
+	clear(stack.data[bottom:])
+	stack.data = stack.data[:bottom]
+	stack.top = bottom - 1
+	stack._shrink()
+	return vals, nil
+}
+
+// Clear removes all the elements from the stack and releases the underlying
+// array, the stack starts over with its default capacity
+func (stack *Stack[T]) Clear() {
+	stack.data = make([]T, 0, stack.minCap)
+	stack.top = -1
+}
+
+// Clone returns a new Stack holding the same elements in the same order, with
+// the same default capacity and shrink policy
+func (stack *Stack[T]) Clone() *Stack[T] {
+	clone := NewWithShrinkPolicy[T](uint(max(stack.minCap, len(stack.data))), stack.shrink)
+	clone.minCap = stack.minCap
+	clone.PushAll(stack.data...)
+	return clone
+}
+
+// String returns the elements from the top to the bottom, e.g. "[3 2 1]", the
+// same format as the String of a LinkedList
+func (stack *Stack[T]) String() string {
+	els := make([]string, 0, stack.Size())
+	for i := stack.top; i >= 0; i-- {
+		els = append(els, fmt.Sprint(stack.data[i]))
+	}
+	return "[" + strings.Join(els, " ") + "]"
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	stackInterface "github.com/yuhlau/go-data-structures/stack"
+	"github.com/yuhlau/go-data-structures/stack/stackTest"
 )
 
 func TestSliceStack(t *testing.T) {
@@ -112,3 +114,39 @@ func TestSize(t *testing.T) {
 	stack.Push(3) // [1 2 3]
 	assert.Equal(uint(3), stack.Size())
 }
+
+func TestAll(t *testing.T) {
+	assert := assert.New(t)
+
+	stack := NewSliceStack()
+	for range stack.All() {
+		assert.Fail("empty stack should not yield")
+	}
+
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3) // [1 2 3]
+
+	depths := []int{}
+	vals := []interface{}{}
+	for depth, val := range stack.All() {
+		depths = append(depths, depth)
+		vals = append(vals, val)
+	}
+	assert.Equal([]int{0, 1, 2}, depths)
+	assert.Equal([]interface{}{3, 2, 1}, vals)
+
+	vals = []interface{}{}
+	for _, val := range stack.All() {
+		if val == 2 {
+			break
+		}
+		vals = append(vals, val)
+	}
+	assert.Equal([]interface{}{3}, vals)
+	assert.Equal(uint(3), stack.Size())
+}
+
+func TestConformance(t *testing.T) {
+	stackTest.Run(t, func() stackInterface.Stack { return NewSliceStack() })
+}
@@ -0,0 +1,25 @@
+package stack
+
+// SliceStack is the Stack holding interface{} elements
+type SliceStack = Stack[interface{}]
+
+// Create and return a new Array Stack with the default capacity of the
+// underlying array.
+func NewSliceStack() *SliceStack {
+	return New[interface{}]()
+}
+
+// Create and return a new Array Stack with specified default capacity of the
+// underlying array. The default capacity is just for initialization and the
+// array will expands itself when the number of elements in stack exceed the
+// size
+func NewSliceStackWithDefaultCap(defaultCap uint) *SliceStack {
+	return NewWithDefaultCap[interface{}](defaultCap)
+}
+
+// Create and return a new Array Stack with specified default capacity of the
+// underlying array, which releases memory after Pop as decided by the policy,
+// never below the default capacity. A nil policy never shrinks the array
+func NewSliceStackWithShrinkPolicy(defaultCap uint, policy ShrinkPolicy) *SliceStack {
+	return NewWithShrinkPolicy[interface{}](defaultCap, policy)
+}
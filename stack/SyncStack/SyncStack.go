@@ -0,0 +1,64 @@
+package stack
+
+import (
+	"sync"
+
+	"github.com/yuhlau/go-data-structures/stack"
+)
+
+// SyncStack makes any stack safe for concurrent use by guarding every call
+// with a mutex
+type SyncStack[T any] struct {
+	mutex sync.Mutex
+	stack stack.TypedStack[T]
+}
+
+// New creates and returns a SyncStack wrapping the provided stack, which
+// should not be used directly anymore
+func New[T any](s stack.TypedStack[T]) *SyncStack[T] {
+	return &SyncStack[T]{stack: s}
+}
+
+// Push inserts an element to the top of stack
+func (s *SyncStack[T]) Push(val T) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.stack.Push(val)
+}
+
+// Pop removes and returns the topmost element from stack, error if the stack
+// is empty
+func (s *SyncStack[T]) Pop() (T, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.stack.Pop()
+}
+
+// Top returns the topmost element from stack, error if the stack is empty
+func (s *SyncStack[T]) Top() (T, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.stack.Top()
+}
+
+// IsEmpty returns whether the stack is empty
+func (s *SyncStack[T]) IsEmpty() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.stack.IsEmpty()
+}
+
+// Size returns the number of elements in the stack
+func (s *SyncStack[T]) Size() uint {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.stack.Size()
+}
+
+// Do calls fn with the wrapped stack while holding the lock, so that several
+// operations happen atomically. fn must not keep the stack after returning
+func (s *SyncStack[T]) Do(fn func(stack.TypedStack[T])) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	fn(s.stack)
+}
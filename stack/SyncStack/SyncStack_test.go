@@ -0,0 +1,56 @@
+package stack
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yuhlau/go-data-structures/stack"
+	linkedListStack "github.com/yuhlau/go-data-structures/stack/LinkedListStack"
+	sliceStack "github.com/yuhlau/go-data-structures/stack/SliceStack"
+	"github.com/yuhlau/go-data-structures/stack/stackTest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Run("SliceStack", func(t *testing.T) {
+		stackTest.Run(t, func() stack.Stack { return New[interface{}](sliceStack.NewSliceStack()) })
+	})
+	t.Run("LinkedListStack", func(t *testing.T) {
+		stackTest.Run(t, func() stack.Stack { return New[interface{}](linkedListStack.NewLinkedListStack()) })
+	})
+}
+
+func TestStress(t *testing.T) {
+	t.Run("SliceStack", func(t *testing.T) { stackTest.Stress(t, New[int](sliceStack.New[int]())) })
+	t.Run("LinkedListStack", func(t *testing.T) { stackTest.Stress(t, New[int](linkedListStack.New[int]())) })
+}
+
+func TestDo(t *testing.T) {
+	assert := assert.New(t)
+
+	s := New[int](sliceStack.New[int]())
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				// Push a pair atomically, the elements of a pair stay together
+				s.Do(func(inner stack.TypedStack[int]) {
+					inner.Push(i)
+					inner.Push(-i)
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(uint(16000), s.Size())
+	for !s.IsEmpty() {
+		s.Do(func(inner stack.TypedStack[int]) {
+			negative, _ := inner.Pop()
+			positive, _ := inner.Pop()
+			assert.Equal(positive, -negative)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package stack
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// Stack is a lock-free stack safe for concurrent use, known as a Treiber
+// stack. The top of stack is swapped with an atomic compare-and-swap, and as
+// a node is never reused once popped, it cannot come back to the top while a
+// goroutine still holds it. The garbage collector only frees a node when no
+// goroutine references it anymore, which rules out the ABA problem
+type Stack[T any] struct {
+	top atomic.Pointer[node[T]]
+}
+
+type node[T any] struct {
+	val  T
+	next *node[T]
+	// size is the number of elements in the stack with this node on top
+	size uint
+}
+
+// New creates and returns an empty Stack of elements of type T
+func New[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+// Push inserts an element to the top of stack
+func (stack *Stack[T]) Push(val T) {
+	n := &node[T]{val: val}
+	for {
+		top := stack.top.Load()
+		n.next = top
+		n.size = 1
+		if top != nil {
+			n.size += top.size
+		}
+		if stack.top.CompareAndSwap(top, n) {
+			return
+		}
+	}
+}
+
+// Pop removes and returns the topmost element from stack, error if the stack
+// is empty
+func (stack *Stack[T]) Pop() (T, error) {
+	for {
+		top := stack.top.Load()
+		if top == nil {
+			var zero T
+			return zero, errors.New("Stack is empty")
+		}
+		if stack.top.CompareAndSwap(top, top.next) {
+			return top.val, nil
+		}
+	}
+}
+
+// Top returns the topmost element from stack, error if the stack is empty
+func (stack *Stack[T]) Top() (T, error) {
+	top := stack.top.Load()
+	if top == nil {
+		var zero T
+		return zero, errors.New("Stack is empty")
+	}
+	return top.val, nil
+}
+
+// IsEmpty returns whether the stack is empty
+func (stack *Stack[T]) IsEmpty() bool {
+	return stack.top.Load() == nil
+}
+
+// Size returns the number of elements in the stack, as of the moment the top
+// of stack was read
+func (stack *Stack[T]) Size() uint {
+	top := stack.top.Load()
+	if top == nil {
+		return 0
+	}
+	return top.size
+}
@@ -0,0 +1,58 @@
+package stack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yuhlau/go-data-structures/stack"
+	linkedListStack "github.com/yuhlau/go-data-structures/stack/LinkedListStack"
+	sliceStack "github.com/yuhlau/go-data-structures/stack/SliceStack"
+	syncStack "github.com/yuhlau/go-data-structures/stack/SyncStack"
+	"github.com/yuhlau/go-data-structures/stack/stackTest"
+)
+
+func TestConformance(t *testing.T) {
+	stackTest.Run(t, func() stack.Stack { return New[interface{}]() })
+}
+
+func TestSize(t *testing.T) {
+	assert := assert.New(t)
+
+	s := New[int]()
+	assert.Equal(uint(0), s.Size())
+	s.Push(1)
+	s.Push(2)
+	assert.Equal(uint(2), s.Size())
+	s.Pop()
+	assert.Equal(uint(1), s.Size())
+}
+
+func TestStress(t *testing.T) {
+	stackTest.Stress(t, New[int]())
+}
+
+// benchmarkContention has every goroutine push then pop in a loop on the
+// same stack
+func benchmarkContention(b *testing.B, s stack.TypedStack[int]) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			s.Push(i)
+			s.Pop()
+			i++
+		}
+	})
+}
+
+func BenchmarkContentionTreiber(b *testing.B) {
+	benchmarkContention(b, New[int]())
+}
+
+func BenchmarkContentionSyncSliceStack(b *testing.B) {
+	benchmarkContention(b, syncStack.New[int](sliceStack.New[int]()))
+}
+
+func BenchmarkContentionSyncLinkedListStack(b *testing.B) {
+	benchmarkContention(b, syncStack.New[int](linkedListStack.New[int]()))
+}
@@ -0,0 +1,23 @@
+// Package stack defines the Stack interface shared by the stack
+// implementations in its sub-packages, so that they can be swapped for one
+// another
+package stack
+
+// Stack is a last in, first out collection of elements of any type
+type Stack = TypedStack[interface{}]
+
+// TypedStack is a last in, first out collection of elements of type T,
+// implemented by the generic stacks
+type TypedStack[T any] interface {
+	// Push inserts an element to the top of stack
+	Push(val T)
+	// Pop removes and returns the topmost element from stack, error if the
+	// stack is empty
+	Pop() (T, error)
+	// Top returns the topmost element from stack, error if the stack is empty
+	Top() (T, error)
+	// IsEmpty returns whether the stack is empty
+	IsEmpty() bool
+	// Size returns the number of elements in the stack
+	Size() uint
+}
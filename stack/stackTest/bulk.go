@@ -0,0 +1,127 @@
+package stackTest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yuhlau/go-data-structures/linkedList"
+	"github.com/yuhlau/go-data-structures/stack"
+)
+
+// BulkStack is a stack of ints with the bulk operations checked by RunBulk,
+// S being the type of the stack itself as returned by Clone
+type BulkStack[S any] interface {
+	stack.TypedStack[int]
+	Peek(n uint) (int, error)
+	ToSlice() []int
+	PushAll(vals ...int)
+	PopN(n uint) ([]int, error)
+	Clear()
+	Clone() S
+	fmt.Stringer
+}
+
+// RunBulk checks the bulk operations of stacks created by newStack, which
+// must return an empty stack on each call
+func RunBulk[S BulkStack[S]](t *testing.T, newStack func() S) {
+	t.Run("Peek", func(t *testing.T) {
+		assert := assert.New(t)
+
+		s := newStack()
+		_, err := s.Peek(0)
+		assert.Equal("Invalid position", err.Error())
+
+		s.PushAll(1, 2, 3)
+		for n, expected := range []int{3, 2, 1} {
+			val, err := s.Peek(uint(n))
+			assert.Nil(err)
+			assert.Equal(expected, val)
+		}
+		_, err = s.Peek(3)
+		assert.Equal("Invalid position", err.Error())
+		assert.Equal(uint(3), s.Size())
+	})
+
+	t.Run("PushAll and ToSlice", func(t *testing.T) {
+		assert := assert.New(t)
+
+		s := newStack()
+		assert.Equal([]int{}, s.ToSlice())
+		s.Push(0)
+		s.PushAll()
+		s.PushAll(1, 2, 3)
+		assert.Equal(uint(4), s.Size())
+		assert.Equal([]int{3, 2, 1, 0}, s.ToSlice())
+		top, _ := s.Pop()
+		assert.Equal(3, top)
+	})
+
+	t.Run("PopN", func(t *testing.T) {
+		assert := assert.New(t)
+
+		s := newStack()
+		s.PushAll(1, 2, 3, 4, 5)
+
+		_, err := s.PopN(6)
+		assert.Equal("Stack has fewer elements than requested", err.Error())
+		assert.Equal(uint(5), s.Size())
+
+		vals, err := s.PopN(0)
+		assert.Nil(err)
+		assert.Equal([]int{}, vals)
+
+		vals, err = s.PopN(3)
+		assert.Nil(err)
+		assert.Equal([]int{5, 4, 3}, vals)
+		assert.Equal([]int{2, 1}, s.ToSlice())
+		top, _ := s.Top()
+		assert.Equal(2, top)
+
+		vals, _ = s.PopN(2)
+		assert.Equal([]int{2, 1}, vals)
+		assert.Equal(true, s.IsEmpty())
+		s.Push(6)
+		assert.Equal([]int{6}, s.ToSlice())
+	})
+
+	t.Run("Clear and Clone", func(t *testing.T) {
+		assert := assert.New(t)
+
+		s := newStack()
+		s.PushAll(1, 2, 3)
+		clone := s.Clone()
+		assert.Equal(s.ToSlice(), clone.ToSlice())
+
+		// The clone is independent of the original
+		clone.Push(4)
+		s.Pop()
+		assert.Equal([]int{4, 3, 2, 1}, clone.ToSlice())
+		assert.Equal([]int{2, 1}, s.ToSlice())
+
+		s.Clear()
+		assert.Equal(true, s.IsEmpty())
+		assert.Equal(uint(0), s.Size())
+		_, err := s.Top()
+		assert.Equal("Stack is empty", err.Error())
+		s.Push(5)
+		assert.Equal([]int{5}, s.ToSlice())
+		assert.Equal(uint(4), clone.Size())
+	})
+
+	t.Run("String", func(t *testing.T) {
+		assert := assert.New(t)
+
+		s := newStack()
+		assert.Equal("[]", s.String())
+		s.PushAll(1, 2, 3)
+		assert.Equal("[3 2 1]", s.String())
+
+		// Matches the LinkedList holding the elements from the top down
+		list := linkedList.New[int]()
+		for _, val := range s.ToSlice() {
+			list.Append(val)
+		}
+		assert.Equal(list.String(), s.String())
+	})
+}
@@ -0,0 +1,142 @@
+// Package stackTest provides a conformance test suite for implementations of
+// the stack.Stack interface
+package stackTest
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yuhlau/go-data-structures/stack"
+)
+
+const (
+	OP_PUSH = iota
+	OP_POP
+	OP_TOP
+)
+
+// Op is one operation of a test case. For OP_PUSH, Val is pushed; for OP_POP
+// and OP_TOP, Val is the element expected, nil together with an error if the
+// stack is expected to be empty
+type Op struct {
+	Kind int
+	Val  interface{}
+}
+
+// Push, Pop and Top build the operations of a test case
+func Push(val interface{}) Op { return Op{OP_PUSH, val} }
+func Pop(val interface{}) Op  { return Op{OP_POP, val} }
+func Top(val interface{}) Op  { return Op{OP_TOP, val} }
+
+// TestCase is a named sequence of operations run against an empty stack
+type TestCase struct {
+	Name string
+	Ops  []Op
+}
+
+// TestCases are the test cases run by Run
+var TestCases = []TestCase{
+	{"empty", []Op{Pop(nil), Top(nil)}},
+	{"push then pop", []Op{Push(1), Pop(1), Pop(nil)}},
+	{"last in first out", []Op{
+		Push(1), Push(2), Push(3), Pop(3), Pop(2), Pop(1), Pop(nil),
+	}},
+	{"top does not remove", []Op{Push(1), Top(1), Top(1), Pop(1), Top(nil)}},
+	{"interleaved", []Op{
+		Push(1), Push(2), Pop(2), Push(3), Top(3), Push(4), Pop(4), Pop(3),
+		Push(5), Pop(5), Pop(1), Pop(nil),
+	}},
+	{"refill after empty", []Op{Push(1), Pop(1), Pop(nil), Push(2), Top(2)}},
+	{"nil element", []Op{Push(nil), Push(1), Pop(1), Top(nil)}},
+	{"mixed types", []Op{Push("a"), Push(1.5), Push([]int{1}), Pop([]int{1}), Pop(1.5), Pop("a")}},
+}
+
+// Run runs every test case of TestCases and a randomized sequence of
+// operations against stacks created by newStack, which must return an empty
+// stack on each call. After each operation the size and emptiness of the
+// stack are checked against a slice based model
+func Run(t *testing.T, newStack func() stack.Stack) {
+	for _, testCase := range TestCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			RunOps(t, newStack(), testCase.Ops)
+		})
+	}
+	t.Run("randomized", func(t *testing.T) {
+		RunOps(t, newStack(), RandomOps(rand.New(rand.NewSource(1)), 1000))
+	})
+	t.Run("large", func(t *testing.T) {
+		ops := []Op{}
+		for i := 0; i < 10000; i++ {
+			ops = append(ops, Push(i))
+		}
+		for i := 9999; i >= 0; i-- {
+			ops = append(ops, Pop(i))
+		}
+		RunOps(t, newStack(), append(ops, Pop(nil)))
+	})
+}
+
+// RunOps runs the operations against the stack and checks the results
+func RunOps(t *testing.T, s stack.Stack, ops []Op) {
+	assert := assert.New(t)
+
+	model := []interface{}{}
+	for i, op := range ops {
+		step := fmt.Sprintf("op %d", i)
+		switch op.Kind {
+		case OP_PUSH:
+			s.Push(op.Val)
+			model = append(model, op.Val)
+		case OP_POP, OP_TOP:
+			var val interface{}
+			var err error
+			if op.Kind == OP_POP {
+				val, err = s.Pop()
+			} else {
+				val, err = s.Top()
+			}
+			if len(model) == 0 {
+				assert.Nil(val, step)
+				assert.NotNil(err, step)
+				break
+			}
+			assert.Nil(err, step)
+			assert.Equal(op.Val, val, step)
+			if op.Kind == OP_POP {
+				model = model[:len(model)-1]
+			}
+		}
+		assert.Equal(uint(len(model)), s.Size(), step)
+		assert.Equal(len(model) == 0, s.IsEmpty(), step)
+	}
+}
+
+// RandomOps returns n random operations along with the results expected from
+// a stack which behaves correctly
+func RandomOps(r *rand.Rand, n int) []Op {
+	ops := make([]Op, 0, n)
+	model := []interface{}{}
+	for i := 0; i < n; i++ {
+		switch r.Intn(3) {
+		case 0:
+			ops = append(ops, Push(i))
+			model = append(model, i)
+		case 1:
+			if len(model) == 0 {
+				ops = append(ops, Pop(nil))
+				break
+			}
+			ops = append(ops, Pop(model[len(model)-1]))
+			model = model[:len(model)-1]
+		case 2:
+			if len(model) == 0 {
+				ops = append(ops, Top(nil))
+				break
+			}
+			ops = append(ops, Top(model[len(model)-1]))
+		}
+	}
+	return ops
+}
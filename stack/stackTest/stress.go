@@ -0,0 +1,59 @@
+package stackTest
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yuhlau/go-data-structures/stack"
+)
+
+// Stress pushes and pops concurrently from several goroutines on the stack,
+// which must be empty and safe for concurrent use, and checks that every
+// element pushed is popped exactly once
+func Stress(t *testing.T, s stack.TypedStack[int]) {
+	assert := assert.New(t)
+
+	const goroutines, perGoroutine = 8, 5000
+	popped := make([][]int, goroutines)
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				s.Push(g*perGoroutine + i)
+				if i%2 == 1 {
+					for j := 0; j < 2; j++ {
+						if val, err := s.Pop(); err == nil {
+							popped[g] = append(popped[g], val)
+						}
+					}
+				}
+				s.Top()
+				s.Size()
+				s.IsEmpty()
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	seen := make([]bool, goroutines*perGoroutine)
+	count := 0
+	record := func(val int) {
+		assert.Equal(false, seen[val], "%d popped twice", val)
+		seen[val] = true
+		count++
+	}
+	for _, vals := range popped {
+		for _, val := range vals {
+			record(val)
+		}
+	}
+	for !s.IsEmpty() {
+		val, _ := s.Pop()
+		record(val)
+	}
+	assert.Equal(goroutines*perGoroutine, count)
+	assert.Equal(uint(0), s.Size())
+}
@@ -0,0 +1,89 @@
+package stackTest
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yuhlau/go-data-structures/stack"
+)
+
+// RunTyped checks stacks of elements of type T created by newStack, which
+// must return an empty stack on each call, by pushing and popping vals. If
+// the stack has an All method, its order is checked as well
+func RunTyped[T any](t *testing.T, newStack func() stack.TypedStack[T], vals []T) {
+	assert := assert.New(t)
+	var zero T
+
+	s := newStack()
+	val, err := s.Pop()
+	assert.Equal(zero, val)
+	assert.Equal("Stack is empty", err.Error())
+	val, err = s.Top()
+	assert.Equal(zero, val)
+	assert.Equal("Stack is empty", err.Error())
+
+	for _, val := range vals {
+		s.Push(val)
+	}
+	assert.Equal(uint(len(vals)), s.Size())
+	assert.Equal(len(vals) == 0, s.IsEmpty())
+	if len(vals) > 0 {
+		top, err := s.Top()
+		assert.Nil(err)
+		assert.Equal(vals[len(vals)-1], top)
+	}
+
+	if iterable, ok := s.(interface{ All() iter.Seq2[int, T] }); ok {
+		depth := 0
+		for i, val := range iterable.All() {
+			assert.Equal(depth, i)
+			assert.Equal(vals[len(vals)-1-i], val)
+			depth++
+		}
+		assert.Equal(len(vals), depth)
+	}
+
+	for i := len(vals) - 1; i >= 0; i-- {
+		val, err := s.Pop()
+		assert.Nil(err)
+		assert.Equal(vals[i], val)
+	}
+	assert.Equal(true, s.IsEmpty())
+	assert.Equal(uint(0), s.Size())
+}
+
+// benchmarkStackSize is the number of ints pushed then popped by each
+// iteration of BenchmarkPushPop
+const benchmarkStackSize = 1000
+
+// BenchmarkPushPop compares a stack created by newBoxed, through which every
+// int outside the small preallocated range is boxed on Push, with a stack of
+// ints created by newGeneric, which stores them unboxed
+func BenchmarkPushPop(b *testing.B, newBoxed func() stack.Stack, newGeneric func() stack.TypedStack[int]) {
+	b.Run("boxed", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s := newBoxed()
+			for j := 0; j < benchmarkStackSize; j++ {
+				s.Push(j + 1000)
+			}
+			for !s.IsEmpty() {
+				val, _ := s.Pop()
+				_ = val.(int)
+			}
+		}
+	})
+	b.Run("generic", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s := newGeneric()
+			for j := 0; j < benchmarkStackSize; j++ {
+				s.Push(j + 1000)
+			}
+			for !s.IsEmpty() {
+				s.Pop()
+			}
+		}
+	})
+}
@@ -2,8 +2,6 @@ package linkedListTree
 
 import (
 	"errors"
-
-	. "github.com/yuhlau/go-data-structures/tree"
 )
 
 type LinkedListTree struct {
@@ -75,6 +73,7 @@ func (tree *LinkedListTree) AppendSibling(val interface{}) (*LinkedListTree, err
 		return nil, err
 	}
 
+	_debugValidate(sibling)
 	return sibling, nil
 }
 
@@ -87,6 +86,7 @@ func (tree *LinkedListTree) AppendChild(val interface{}) *LinkedListTree {
 	if tree.FirstChild() == nil {
 		// This is the first child of the tree
 		tree.firstChild = child
+		_debugValidate(child)
 		return child
 	}
 
@@ -96,6 +96,7 @@ func (tree *LinkedListTree) AppendChild(val interface{}) *LinkedListTree {
 	}
 	child.SetPrevSibling(current)
 	current.SetNextSibling(child)
+	_debugValidate(child)
 	return child
 }
 
@@ -158,51 +159,213 @@ func (tree *LinkedListTree) FirstChild() *LinkedListTree {
 	return tree.firstChild
 }
 
-func (tree *LinkedListTree) _preOrderTraverse(fn func(interface{}, int), method, depth int) {
-	fn(tree.Val(), depth)
-	if child := tree.FirstChild(); child != nil {
-		for child != nil {
-			child._preOrderTraverse(fn, method, depth+1)
-			child = child.NextSibling()
-		}
-	}
+// Delete deletes the current node from the tree structure. If this is a tree
+// node then it is removed from its parent and siblings and becomes a root of
+// its own subtree; If this is a root then nothing would happen. You should
+// always remove the reference to the tree to allow for garbage collection
+func (tree *LinkedListTree) Delete() {
+	tree.Detach()
 }
 
-func (tree *LinkedListTree) _postOrderTraverse(fn func(interface{}, int), method, depth int) {
-	if child := tree.FirstChild(); child != nil {
-		for child != nil {
-			child._postOrderTraverse(fn, method, depth+1)
-			child = child.NextSibling()
+// Detach removes the tree from its parent and siblings and returns it as a
+// standalone root, together with all its descendants. Detaching a root has
+// no effect
+func (tree *LinkedListTree) Detach() *LinkedListTree {
+	if tree.IsRoot() {
+		return tree
+	}
+	if tree.prevSibling == nil {
+		// The tree is the first child in its parent, unless it was created with
+		// a parent but never linked to it
+		if tree.parent.firstChild == tree {
+			tree.parent.firstChild = tree.nextSibling
 		}
+	} else {
+		tree.prevSibling.nextSibling = tree.nextSibling
 	}
-	fn(tree.Val(), depth)
+	if tree.nextSibling != nil {
+		tree.nextSibling.prevSibling = tree.prevSibling
+	}
+	parent := tree.parent
+	tree.parent, tree.prevSibling, tree.nextSibling = nil, nil, nil
+	_debugValidate(parent, tree)
+	return tree
 }
 
-func (tree *LinkedListTree) Traverse(fn func(interface{}, int), method int) error {
-	switch method {
-	case TRAVERSAL_PRE_ORDER:
-		tree._preOrderTraverse(fn, method, 0)
-	case TRAVERSAL_POST_ORDER:
-		tree._postOrderTraverse(fn, method, 0)
-	default:
-		return errors.New("Unsupported traversal method")
+// MoveTo detaches the tree and inserts it as the child of newParent at the
+// specified position, counted among the children of newParent once the tree
+// has been detached. Returns error if newParent is nil, if the tree is
+// newParent or one of its ancestors, or if the position is invalid
+func (tree *LinkedListTree) MoveTo(newParent *LinkedListTree, pos uint) error {
+	if newParent == nil {
+		return errors.New("Cannot move a tree under a nil parent")
+	}
+	if tree._isAncestorOrSelf(newParent) {
+		return errors.New("Cannot move a tree under itself or its descendants")
 	}
+	if pos > newParent._countChildrenExcept(tree) {
+		return errors.New("Invalid position")
+	}
+	tree.Detach()
+	prev, _ := newParent._childBefore(pos)
+	newParent._link(prev, tree)
+	_debugValidate(tree)
 	return nil
 }
 
-// Delete deletes the current node from the tree structure. If this is a tree
-// node then it is removed from its parent and siblings; If this is a root
-// then nothing would happen. You should always remove the reference to the
-// tree to allow for garbage collection
-func (tree *LinkedListTree) Delete() {
+// InsertChildAt inserts a child with the specified value at the position
+// among the children and returns the pointer to the created child, error if
+// the position is greater than the number of children
+func (tree *LinkedListTree) InsertChildAt(pos uint, val interface{}) (*LinkedListTree, error) {
+	prev, err := tree._childBefore(pos)
+	if err != nil {
+		return nil, err
+	}
+	child := NewLinkedListTreeNode(val, tree)
+	tree._link(prev, child)
+	_debugValidate(child)
+	return child, nil
+}
+
+// InsertSiblingBefore inserts a sibling with the specified value right before
+// the tree and returns the pointer to the created sibling, error if the tree
+// is the root
+func (tree *LinkedListTree) InsertSiblingBefore(val interface{}) (*LinkedListTree, error) {
+	if tree.IsRoot() {
+		return nil, errors.New("Root cannot have sibling")
+	}
+	sibling := NewLinkedListTreeNode(val, tree.parent)
+	tree.parent._link(tree.prevSibling, sibling)
+	_debugValidate(sibling)
+	return sibling, nil
+}
+
+// InsertSiblingAfter inserts a sibling with the specified value right after
+// the tree and returns the pointer to the created sibling, error if the tree
+// is the root
+func (tree *LinkedListTree) InsertSiblingAfter(val interface{}) (*LinkedListTree, error) {
+	if tree.IsRoot() {
+		return nil, errors.New("Root cannot have sibling")
+	}
+	sibling := NewLinkedListTreeNode(val, tree.parent)
+	tree.parent._link(tree, sibling)
+	_debugValidate(sibling)
+	return sibling, nil
+}
+
+// ReplaceWith puts subtree at the place of the tree and detaches the tree.
+// The subtree is detached from wherever it was first, so it may come from the
+// same tree. Returns error if the tree is a root, if subtree is nil, or if
+// subtree is an ancestor of the tree
+func (tree *LinkedListTree) ReplaceWith(subtree *LinkedListTree) error {
+	if subtree == nil {
+		return errors.New("Cannot replace a tree with nil")
+	}
+	if subtree == tree {
+		return nil
+	}
 	if tree.IsRoot() {
-		return
+		return errors.New("Root cannot be replaced")
+	}
+	if subtree._isAncestorOrSelf(tree) {
+		return errors.New("Cannot replace a tree with its ancestor")
+	}
+	subtree.Detach()
+	parent, prev := tree.parent, tree.prevSibling
+	tree.Detach()
+	parent._link(prev, subtree)
+	_debugValidate(subtree, tree)
+	return nil
+}
+
+// AdoptChildren moves all the children of other to the end of the children
+// of the tree, keeping their order. Returns error if the tree is a descendant
+// of other
+func (tree *LinkedListTree) AdoptChildren(other *LinkedListTree) error {
+	if other == nil || other == tree {
+		return nil
+	}
+	if other._isAncestorOrSelf(tree) {
+		return errors.New("Cannot adopt the children of an ancestor")
+	}
+	first := other.firstChild
+	if first == nil {
+		return nil
+	}
+	other.firstChild = nil
+	for child := first; child != nil; child = child.nextSibling {
+		child.parent = tree
+	}
+	if last := tree._lastChild(); last == nil {
+		tree.firstChild = first
+	} else {
+		last.nextSibling = first
+		first.prevSibling = last
+	}
+	_debugValidate(tree, other)
+	return nil
+}
+
+// _isAncestorOrSelf returns whether the tree is node or one of its ancestors
+func (tree *LinkedListTree) _isAncestorOrSelf(node *LinkedListTree) bool {
+	for ; node != nil; node = node.parent {
+		if node == tree {
+			return true
+		}
 	}
-	// Remove itself from siblings list and/or parent
-	if tree.PrevSibling() == nil {
-		// The tree is the first child in its parent
-		tree.Parent().SetFirstChild(tree.NextSibling())
+	return false
+}
+
+// _countChildrenExcept returns the number of children other than except
+func (tree *LinkedListTree) _countChildrenExcept(except *LinkedListTree) uint {
+	var count uint = 0
+	for child := tree.firstChild; child != nil; child = child.nextSibling {
+		if child != except {
+			count++
+		}
+	}
+	return count
+}
+
+// _lastChild returns the last child, nil if the tree has no child
+func (tree *LinkedListTree) _lastChild() *LinkedListTree {
+	child := tree.firstChild
+	for child != nil && child.nextSibling != nil {
+		child = child.nextSibling
+	}
+	return child
+}
+
+// _childBefore returns the child which a new child inserted at the position
+// would follow, nil when inserting as the first child, or error if the
+// position is greater than the number of children
+func (tree *LinkedListTree) _childBefore(pos uint) (*LinkedListTree, error) {
+	if pos == 0 {
+		return nil, nil
+	}
+	prev := tree.firstChild
+	for i := uint(1); i < pos && prev != nil; i++ {
+		prev = prev.nextSibling
+	}
+	if prev == nil {
+		return nil, errors.New("Invalid position")
+	}
+	return prev, nil
+}
+
+// _link links the root node as a child of the tree right after prev, or as
+// the first child if prev is nil
+func (tree *LinkedListTree) _link(prev, node *LinkedListTree) {
+	node.parent = tree
+	node.prevSibling = prev
+	if prev == nil {
+		node.nextSibling = tree.firstChild
+		tree.firstChild = node
 	} else {
-		tree.PrevSibling().SetNextSibling(tree.NextSibling())
+		node.nextSibling = prev.nextSibling
+		prev.nextSibling = node
+	}
+	if node.nextSibling != nil {
+		node.nextSibling.prevSibling = node
 	}
 }
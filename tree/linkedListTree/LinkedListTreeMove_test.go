@@ -0,0 +1,268 @@
+package linkedListTree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertConsistent checks the parent, sibling and first child links of every
+// node under root agree with each other
+func assertConsistent(assert *assert.Assertions, root *LinkedListTree) {
+	assert.Nil(root.Validate())
+	assert.Nil(root.Parent())
+	assert.Nil(root.PrevSibling())
+	assert.Nil(root.NextSibling())
+	for node := range root.PreOrder() {
+		var prev *LinkedListTree
+		for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+			assert.Equal(node, child.Parent())
+			assert.Equal(prev, child.PrevSibling())
+			prev = child
+		}
+	}
+}
+
+// childVals returns the values of the children of tree
+func childVals(tree *LinkedListTree) []interface{} {
+	vals := []interface{}{}
+	for child := tree.FirstChild(); child != nil; child = child.NextSibling() {
+		vals = append(vals, child.Val())
+	}
+	return vals
+}
+
+func TestDeleteRelinksSiblings(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	tree := NewLinkedListTree(1)
+	tree.AppendChild(2)
+	child := tree.AppendChild(3)
+	last := tree.AppendChild(4)
+	child.Delete()
+
+	assert.Equal([]interface{}{2, 4}, childVals(tree))
+	assert.Equal(tree.FirstChild(), last.PrevSibling())
+	assert.Nil(child.Parent())
+	assertConsistent(assert, tree)
+}
+
+func TestDetach(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	tree := newSampleTree()
+	first := tree.FirstChild()
+	detached := first.Detach()
+
+	assert.Equal(first, detached)
+	assert.Equal(true, detached.IsRoot())
+	assert.Equal([]interface{}{4, 5}, childVals(detached))
+	assert.Equal([]interface{}{3}, childVals(tree))
+	assertConsistent(assert, tree)
+	assertConsistent(assert, detached)
+
+	// Detaching a root does nothing
+	assert.Equal(tree, tree.Detach())
+	assert.Equal([]interface{}{3}, childVals(tree))
+
+	// A node created with a parent but never linked leaves the parent intact
+	unlinked := NewLinkedListTreeNode(9, tree)
+	unlinked.Detach()
+	assert.Equal([]interface{}{3}, childVals(tree))
+}
+
+func TestMoveTo(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	tree := newSampleTree()
+	two := tree.FirstChild()
+	three := two.NextSibling()
+	four := two.FirstChild()
+
+	err := four.MoveTo(three, 1) // 3 -> [6 4]
+	assert.Nil(err)
+	assert.Equal([]interface{}{5}, childVals(two))
+	assert.Equal([]interface{}{6, 4}, childVals(three))
+	assertConsistent(assert, tree)
+
+	// Moving within the same parent counts positions without the tree itself
+	err = four.MoveTo(three, 0) // 3 -> [4 6]
+	assert.Nil(err)
+	assert.Equal([]interface{}{4, 6}, childVals(three))
+	assertConsistent(assert, tree)
+
+	err = three.MoveTo(two, 1)
+	assert.Nil(err)
+	assert.Equal([]interface{}{2}, childVals(tree))
+	assert.Equal([]interface{}{5, 3}, childVals(two))
+	assertConsistent(assert, tree)
+
+	// A detached root can be moved into a tree
+	err = NewLinkedListTree(7).MoveTo(tree, 1)
+	assert.Nil(err)
+	assert.Equal([]interface{}{2, 7}, childVals(tree))
+	assertConsistent(assert, tree)
+}
+
+func TestMoveToErrors(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	tree := newSampleTree()
+	two := tree.FirstChild()
+
+	err := two.MoveTo(nil, 0)
+	assert.Equal("Cannot move a tree under a nil parent", err.Error())
+
+	err = two.MoveTo(two, 0)
+	assert.Equal("Cannot move a tree under itself or its descendants", err.Error())
+
+	err = tree.MoveTo(two.FirstChild(), 0)
+	assert.Equal("Cannot move a tree under itself or its descendants", err.Error())
+
+	err = two.MoveTo(tree, 2)
+	assert.Equal("Invalid position", err.Error())
+
+	// Nothing moved
+	expected, _ := collect(newSampleTree().PreOrder())
+	vals, _ := collect(tree.PreOrder())
+	assert.Equal(expected, vals)
+	assertConsistent(assert, tree)
+}
+
+func TestInsertChildAt(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	tree := NewLinkedListTree(0)
+	_, err := tree.InsertChildAt(0, 2)
+	assert.Nil(err)
+	_, err = tree.InsertChildAt(0, 1)
+	assert.Nil(err)
+	child, err := tree.InsertChildAt(2, 4)
+	assert.Nil(err)
+	assert.Equal(tree, child.Parent())
+	_, err = tree.InsertChildAt(2, 3)
+	assert.Nil(err)
+	assert.Equal([]interface{}{1, 2, 3, 4}, childVals(tree))
+	assertConsistent(assert, tree)
+
+	child, err = tree.InsertChildAt(5, 6)
+	assert.Nil(child)
+	assert.Equal("Invalid position", err.Error())
+}
+
+func TestInsertSibling(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	tree := NewLinkedListTree(0)
+	child := tree.AppendChild(2)
+	_, err := child.InsertSiblingBefore(1)
+	assert.Nil(err)
+	_, err = child.InsertSiblingAfter(3)
+	assert.Nil(err)
+	last := tree.AppendChild(5)
+	_, err = last.InsertSiblingBefore(4)
+	assert.Nil(err)
+	_, err = last.InsertSiblingAfter(6)
+	assert.Nil(err)
+	assert.Equal([]interface{}{1, 2, 3, 4, 5, 6}, childVals(tree))
+	assertConsistent(assert, tree)
+
+	sibling, err := tree.InsertSiblingBefore(1)
+	assert.Nil(sibling)
+	assert.Equal("Root cannot have sibling", err.Error())
+	sibling, err = tree.InsertSiblingAfter(1)
+	assert.Nil(sibling)
+	assert.Equal("Root cannot have sibling", err.Error())
+}
+
+func TestReplaceWith(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	tree := newSampleTree()
+	two := tree.FirstChild()
+	replacement := NewLinkedListTree(7)
+	replacement.AppendChild(8)
+
+	err := two.ReplaceWith(replacement)
+	assert.Nil(err)
+	assert.Equal([]interface{}{7, 3}, childVals(tree))
+	assert.Equal(true, two.IsRoot())
+	assert.Equal([]interface{}{4, 5}, childVals(two))
+	assertConsistent(assert, tree)
+	assertConsistent(assert, two)
+
+	// Replacing with a sibling moves the sibling
+	three := replacement.NextSibling()
+	err = replacement.ReplaceWith(three)
+	assert.Nil(err)
+	assert.Equal([]interface{}{3}, childVals(tree))
+	assertConsistent(assert, tree)
+
+	// Replacing with a descendant lifts the descendant up
+	err = three.ReplaceWith(three.FirstChild())
+	assert.Nil(err)
+	assert.Equal([]interface{}{6}, childVals(tree))
+	assert.Equal([]interface{}{}, childVals(three))
+	assertConsistent(assert, tree)
+}
+
+func TestReplaceWithErrors(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	tree := newSampleTree()
+	four := tree.FirstChild().FirstChild()
+
+	err := four.ReplaceWith(nil)
+	assert.Equal("Cannot replace a tree with nil", err.Error())
+
+	err = tree.ReplaceWith(NewLinkedListTree(7))
+	assert.Equal("Root cannot be replaced", err.Error())
+
+	err = four.ReplaceWith(tree.FirstChild())
+	assert.Equal("Cannot replace a tree with its ancestor", err.Error())
+
+	assert.Nil(four.ReplaceWith(four))
+	expected, _ := collect(newSampleTree().PreOrder())
+	vals, _ := collect(tree.PreOrder())
+	assert.Equal(expected, vals)
+	assertConsistent(assert, tree)
+}
+
+func TestAdoptChildren(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	tree := newSampleTree()
+	two := tree.FirstChild()
+	three := two.NextSibling()
+
+	err := three.AdoptChildren(two)
+	assert.Nil(err)
+	assert.Equal([]interface{}{}, childVals(two))
+	assert.Equal([]interface{}{6, 4, 5}, childVals(three))
+	assertConsistent(assert, tree)
+
+	// Adopting into a node without children
+	err = two.AdoptChildren(three)
+	assert.Nil(err)
+	assert.Equal([]interface{}{6, 4, 5}, childVals(two))
+	assertConsistent(assert, tree)
+
+	// Adopting the children of a descendant
+	err = tree.AdoptChildren(two)
+	assert.Nil(err)
+	assert.Equal([]interface{}{2, 3, 6, 4, 5}, childVals(tree))
+	assertConsistent(assert, tree)
+
+	err = two.AdoptChildren(tree)
+	assert.Equal("Cannot adopt the children of an ancestor", err.Error())
+	assert.Nil(tree.AdoptChildren(tree))
+}
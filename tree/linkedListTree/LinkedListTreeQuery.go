@@ -0,0 +1,129 @@
+package linkedListTree
+
+import (
+	. "github.com/yuhlau/go-data-structures/tree"
+)
+
+// Size returns the number of nodes in the tree, including the tree itself
+func (tree *LinkedListTree) Size() uint {
+	var size uint = 0
+	tree.Visit(func(*LinkedListTree, int) int {
+		size++
+		return VISIT_CONTINUE
+	}, TRAVERSAL_PRE_ORDER)
+	return size
+}
+
+// Height returns the number of edges on the longest path from the tree down
+// to a leaf, 0 if the tree has no child
+func (tree *LinkedListTree) Height() int {
+	height := 0
+	tree.Visit(func(node *LinkedListTree, depth int) int {
+		if depth > height {
+			height = depth
+		}
+		return VISIT_CONTINUE
+	}, TRAVERSAL_PRE_ORDER)
+	return height
+}
+
+// Depth returns the number of edges from the root down to the tree, 0 if the
+// tree is the root
+func (tree *LinkedListTree) Depth() int {
+	depth := 0
+	for node := tree.Parent(); node != nil; node = node.Parent() {
+		depth++
+	}
+	return depth
+}
+
+// Degree returns the number of children of the tree
+func (tree *LinkedListTree) Degree() uint {
+	var degree uint = 0
+	for child := tree.FirstChild(); child != nil; child = child.NextSibling() {
+		degree++
+	}
+	return degree
+}
+
+// IsLeaf returns whether the tree has no child
+func (tree *LinkedListTree) IsLeaf() bool {
+	return tree.FirstChild() == nil
+}
+
+// Leaves returns the nodes without child in the tree, from left to right
+func (tree *LinkedListTree) Leaves() []*LinkedListTree {
+	leaves := []*LinkedListTree{}
+	tree.Visit(func(node *LinkedListTree, depth int) int {
+		if node.IsLeaf() {
+			leaves = append(leaves, node)
+		}
+		return VISIT_CONTINUE
+	}, TRAVERSAL_PRE_ORDER)
+	return leaves
+}
+
+// Root returns the root of the tree the node belongs to, the tree itself if
+// it is a root
+func (tree *LinkedListTree) Root() *LinkedListTree {
+	root := tree
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	return root
+}
+
+// Ancestors returns the ancestors of the tree starting from its parent up to
+// the root, empty if the tree is a root
+func (tree *LinkedListTree) Ancestors() []*LinkedListTree {
+	ancestors := []*LinkedListTree{}
+	for node := tree.Parent(); node != nil; node = node.Parent() {
+		ancestors = append(ancestors, node)
+	}
+	return ancestors
+}
+
+// PathFromRoot returns the nodes on the path from the root down to the tree,
+// both included
+func (tree *LinkedListTree) PathFromRoot() []*LinkedListTree {
+	ancestors := tree.Ancestors()
+	path := make([]*LinkedListTree, len(ancestors)+1)
+	for i, node := range ancestors {
+		path[len(ancestors)-1-i] = node
+	}
+	path[len(ancestors)] = tree
+	return path
+}
+
+// Descendants returns the nodes under the tree in pre-order, the tree itself
+// excluded
+func (tree *LinkedListTree) Descendants() []*LinkedListTree {
+	descendants := []*LinkedListTree{}
+	tree.Visit(func(node *LinkedListTree, depth int) int {
+		if depth > 0 {
+			descendants = append(descendants, node)
+		}
+		return VISIT_CONTINUE
+	}, TRAVERSAL_PRE_ORDER)
+	return descendants
+}
+
+// LowestCommonAncestor returns the deepest node which is an ancestor of, or
+// the same node as, both a and b. Returns nil if either node is nil or they
+// do not belong to the same tree
+func LowestCommonAncestor(a, b *LinkedListTree) *LinkedListTree {
+	if a == nil || b == nil {
+		return nil
+	}
+	depthA, depthB := a.Depth(), b.Depth()
+	for ; depthA > depthB; depthA-- {
+		a = a.Parent()
+	}
+	for ; depthB > depthA; depthB-- {
+		b = b.Parent()
+	}
+	for a != b {
+		a, b = a.Parent(), b.Parent()
+	}
+	return a
+}
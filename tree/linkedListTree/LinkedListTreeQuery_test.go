@@ -0,0 +1,150 @@
+package linkedListTree
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// randomTree builds a tree of n nodes where the parent of node i is a random
+// node among 0 to i-1. It returns the nodes indexed by their value along with
+// the parent indices, -1 for the root
+func randomTree(r *rand.Rand, n int) ([]*LinkedListTree, []int) {
+	nodes := make([]*LinkedListTree, n)
+	parents := make([]int, n)
+	nodes[0] = NewLinkedListTree(0)
+	parents[0] = -1
+	for i := 1; i < n; i++ {
+		parents[i] = r.Intn(i)
+		nodes[i] = nodes[parents[i]].AppendChild(i)
+	}
+	return nodes, parents
+}
+
+// bruteAncestors returns the indices of the ancestors of i from its parent to
+// the root
+func bruteAncestors(parents []int, i int) []int {
+	ancestors := []int{}
+	for p := parents[i]; p != -1; p = parents[p] {
+		ancestors = append(ancestors, p)
+	}
+	return ancestors
+}
+
+func isBruteAncestorOrSelf(parents []int, ancestor, i int) bool {
+	for ; i != -1; i = parents[i] {
+		if i == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+func vals(nodes []*LinkedListTree) []int {
+	result := []int{}
+	for _, node := range nodes {
+		result = append(result, node.Val().(int))
+	}
+	return result
+}
+
+func TestQueriesAgainstBruteForce(t *testing.T) {
+	assert := assert.New(t)
+
+	r := rand.New(rand.NewSource(1))
+	for round := 0; round < 20; round++ {
+		n := 1 + r.Intn(60)
+		nodes, parents := randomTree(r, n)
+
+		for i, node := range nodes {
+			ancestors := bruteAncestors(parents, i)
+			assert.Equal(len(ancestors), node.Depth())
+			assert.Equal(ancestors, vals(node.Ancestors()))
+			assert.Equal(nodes[0], node.Root())
+
+			path := []int{}
+			for j := len(ancestors) - 1; j >= 0; j-- {
+				path = append(path, ancestors[j])
+			}
+			assert.Equal(append(path, i), vals(node.PathFromRoot()))
+
+			var size, degree uint = 0, 0
+			height := 0
+			descendants := map[int]bool{}
+			for j := range nodes {
+				if parents[j] == i {
+					degree++
+				}
+				if isBruteAncestorOrSelf(parents, i, j) {
+					size++
+					if d := len(bruteAncestors(parents, j)) - len(ancestors); d > height {
+						height = d
+					}
+					if j != i {
+						descendants[j] = true
+					}
+				}
+			}
+			assert.Equal(size, node.Size())
+			assert.Equal(degree, node.Degree())
+			assert.Equal(degree == 0, node.IsLeaf())
+			assert.Equal(height, node.Height())
+
+			found := map[int]bool{}
+			for _, val := range vals(node.Descendants()) {
+				found[val] = true
+			}
+			assert.Equal(descendants, found)
+
+			for _, leaf := range node.Leaves() {
+				assert.Equal(true, leaf.IsLeaf())
+				assert.Equal(true, descendants[leaf.Val().(int)] || leaf == node)
+			}
+		}
+
+		for k := 0; k < 50; k++ {
+			a, b := r.Intn(n), r.Intn(n)
+			// The brute force LCA is the deepest ancestor of a which is also an
+			// ancestor of b
+			lca := a
+			for !isBruteAncestorOrSelf(parents, lca, b) {
+				lca = parents[lca]
+			}
+			assert.Equal(nodes[lca], LowestCommonAncestor(nodes[a], nodes[b]))
+		}
+	}
+}
+
+func TestQueriesOnSampleTree(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := newUnbalancedTree()
+	assert.Equal(uint(12), tree.Size())
+	assert.Equal(4, tree.Height())
+	assert.Equal(0, tree.Depth())
+	assert.Equal(uint(3), tree.Degree())
+	assert.Equal(false, tree.IsLeaf())
+	assert.Equal([]int{5, 6, 7, 9, 11, 12}, vals(tree.Leaves()))
+	assert.Equal([]int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, vals(tree.Descendants()))
+	assert.Equal([]int{}, vals(tree.Ancestors()))
+	assert.Equal([]int{1}, vals(tree.PathFromRoot()))
+
+	leaf := NewLinkedListTree(0)
+	assert.Equal(uint(1), leaf.Size())
+	assert.Equal(0, leaf.Height())
+	assert.Equal(true, leaf.IsLeaf())
+	assert.Equal([]int{0}, vals(leaf.Leaves()))
+	assert.Equal(leaf, leaf.Root())
+}
+
+func TestLowestCommonAncestorAcrossTrees(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := newSampleTree()
+	other := newSampleTree()
+	assert.Nil(LowestCommonAncestor(tree.FirstChild(), other.FirstChild()))
+	assert.Nil(LowestCommonAncestor(tree, nil))
+	assert.Equal(tree, LowestCommonAncestor(tree, tree))
+	assert.Equal(tree, LowestCommonAncestor(tree.FirstChild().FirstChild(), tree.FirstChild().NextSibling()))
+}
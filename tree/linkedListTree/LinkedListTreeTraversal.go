@@ -0,0 +1,225 @@
+package linkedListTree
+
+import (
+	"errors"
+	"iter"
+
+	stack "github.com/yuhlau/go-data-structures/stack/SliceStack"
+	. "github.com/yuhlau/go-data-structures/tree"
+)
+
+// Traverse calls fn with the value and depth of every node of the tree in the
+// order given by the traversal method, returns error if the method is not
+// supported
+func (tree *LinkedListTree) Traverse(fn func(interface{}, int), method int) error {
+	return tree.Visit(func(node *LinkedListTree, depth int) int {
+		fn(node.Val(), depth)
+		return VISIT_CONTINUE
+	}, method)
+}
+
+// Visit calls fn with every node of the tree and its depth relative to this
+// tree, in the order given by the traversal method. The action returned by fn
+// controls the rest of the traversal:
+//
+//   - VISIT_CONTINUE carries on normally
+//   - VISIT_SKIP_CHILDREN does not visit the children of the node which are
+//     still to come. In pre-order and level order that is all the children;
+//     in in-order that is every child but the first; in post-order and reverse
+//     level order the children are visited before the node, so it is the same
+//     as VISIT_CONTINUE
+//   - VISIT_STOP ends the traversal
+//
+// Returns error if the method is not supported
+func (tree *LinkedListTree) Visit(fn func(*LinkedListTree, int) int, method int) error {
+	switch method {
+	case TRAVERSAL_PRE_ORDER:
+		tree._preOrderVisit(fn)
+	case TRAVERSAL_POST_ORDER:
+		tree._postOrderVisit(fn)
+	case TRAVERSAL_IN_ORDER:
+		tree._inOrderVisit(fn)
+	case TRAVERSAL_LEVEL_ORDER:
+		tree._levelOrderVisit(fn)
+	case TRAVERSAL_REVERSE_LEVEL_ORDER:
+		tree._reverseLevelOrderVisit(fn)
+	default:
+		return errors.New("Unsupported traversal method")
+	}
+	return nil
+}
+
+// PreOrder returns an iterator over the nodes of the tree in pre-order,
+// paired with their depth relative to this tree
+func (tree *LinkedListTree) PreOrder() iter.Seq2[*LinkedListTree, int] {
+	return tree._seq(TRAVERSAL_PRE_ORDER)
+}
+
+// PostOrder returns an iterator over the nodes of the tree in post-order,
+// paired with their depth relative to this tree
+func (tree *LinkedListTree) PostOrder() iter.Seq2[*LinkedListTree, int] {
+	return tree._seq(TRAVERSAL_POST_ORDER)
+}
+
+// InOrder returns an iterator over the nodes of the tree in in-order, paired
+// with their depth relative to this tree. As a node may have any number of
+// children, in-order visits the first child subtree, then the node, then the
+// remaining children subtrees
+func (tree *LinkedListTree) InOrder() iter.Seq2[*LinkedListTree, int] {
+	return tree._seq(TRAVERSAL_IN_ORDER)
+}
+
+// LevelOrder returns an iterator over the nodes of the tree level by level
+// from this tree downwards, paired with their depth relative to this tree
+func (tree *LinkedListTree) LevelOrder() iter.Seq2[*LinkedListTree, int] {
+	return tree._seq(TRAVERSAL_LEVEL_ORDER)
+}
+
+// ReverseLevelOrder returns an iterator over the nodes of the tree level by
+// level from the deepest level up to this tree, paired with their depth
+// relative to this tree. Each level is still visited from left to right
+func (tree *LinkedListTree) ReverseLevelOrder() iter.Seq2[*LinkedListTree, int] {
+	return tree._seq(TRAVERSAL_REVERSE_LEVEL_ORDER)
+}
+
+// _seq adapts Visit with the traversal method to an iterator
+func (tree *LinkedListTree) _seq(method int) iter.Seq2[*LinkedListTree, int] {
+	return func(yield func(*LinkedListTree, int) bool) {
+		tree.Visit(func(node *LinkedListTree, depth int) int {
+			if !yield(node, depth) {
+				return VISIT_STOP
+			}
+			return VISIT_CONTINUE
+		}, method)
+	}
+}
+
+// The depth-first traversals below keep their pending work on an explicit
+// stack of frames instead of recursing, so that the depth of the tree is only
+// bounded by the memory available. A frame either stands for the subtree of
+// a node, for a node and all its next siblings, or for the call to fn on a
+// node
+const (
+	_FRAME_SUBTREE = iota
+	_FRAME_SIBLINGS
+	_FRAME_VISIT
+)
+
+type _frame struct {
+	node  *LinkedListTree
+	depth int
+	kind  int
+}
+
+func _pushFrame(frames *stack.Stack[_frame], node *LinkedListTree, depth, kind int) {
+	if node != nil {
+		frames.Push(_frame{node, depth, kind})
+	}
+}
+
+// _walk drives the depth-first traversals. expand pushes the frames making up
+// the subtree of a node, and visit reports whether to carry on after fn
+// returned action on a node
+func (tree *LinkedListTree) _walk(
+	fn func(*LinkedListTree, int) int,
+	expand func(frames *stack.Stack[_frame], node *LinkedListTree, depth int),
+	visit func(frames *stack.Stack[_frame], node *LinkedListTree, depth, action int) bool,
+) {
+	frames := stack.New[_frame]()
+	_pushFrame(frames, tree, 0, _FRAME_SUBTREE)
+	for !frames.IsEmpty() {
+		frame, _ := frames.Pop()
+		switch frame.kind {
+		case _FRAME_SIBLINGS:
+			_pushFrame(frames, frame.node.NextSibling(), frame.depth, _FRAME_SIBLINGS)
+			expand(frames, frame.node, frame.depth)
+		case _FRAME_SUBTREE:
+			expand(frames, frame.node, frame.depth)
+		case _FRAME_VISIT:
+			if !visit(frames, frame.node, frame.depth, fn(frame.node, frame.depth)) {
+				return
+			}
+		}
+	}
+}
+
+func (tree *LinkedListTree) _preOrderVisit(fn func(*LinkedListTree, int) int) {
+	tree._walk(fn, func(frames *stack.Stack[_frame], node *LinkedListTree, depth int) {
+		_pushFrame(frames, node, depth, _FRAME_VISIT)
+	}, func(frames *stack.Stack[_frame], node *LinkedListTree, depth, action int) bool {
+		if action == VISIT_CONTINUE {
+			_pushFrame(frames, node.FirstChild(), depth+1, _FRAME_SIBLINGS)
+		}
+		return action != VISIT_STOP
+	})
+}
+
+func (tree *LinkedListTree) _postOrderVisit(fn func(*LinkedListTree, int) int) {
+	tree._walk(fn, func(frames *stack.Stack[_frame], node *LinkedListTree, depth int) {
+		_pushFrame(frames, node, depth, _FRAME_VISIT)
+		_pushFrame(frames, node.FirstChild(), depth+1, _FRAME_SIBLINGS)
+	}, func(frames *stack.Stack[_frame], node *LinkedListTree, depth, action int) bool {
+		return action != VISIT_STOP
+	})
+}
+
+func (tree *LinkedListTree) _inOrderVisit(fn func(*LinkedListTree, int) int) {
+	tree._walk(fn, func(frames *stack.Stack[_frame], node *LinkedListTree, depth int) {
+		first := node.FirstChild()
+		if first != nil {
+			_pushFrame(frames, first.NextSibling(), depth+1, _FRAME_SIBLINGS)
+		}
+		_pushFrame(frames, node, depth, _FRAME_VISIT)
+		_pushFrame(frames, first, depth+1, _FRAME_SUBTREE)
+	}, func(frames *stack.Stack[_frame], node *LinkedListTree, depth, action int) bool {
+		if action == VISIT_SKIP_CHILDREN && node.FirstChild() != nil && node.FirstChild().NextSibling() != nil {
+			// Drop the frame of the remaining children pushed by expand
+			frames.Pop()
+		}
+		return action != VISIT_STOP
+	})
+}
+
+func (tree *LinkedListTree) _levelOrderVisit(fn func(*LinkedListTree, int) int) {
+	level := []*LinkedListTree{tree}
+	for depth := 0; len(level) > 0; depth++ {
+		next := []*LinkedListTree{}
+		for _, node := range level {
+			switch fn(node, depth) {
+			case VISIT_STOP:
+				return
+			case VISIT_SKIP_CHILDREN:
+				continue
+			}
+			next = _appendChildren(next, node)
+		}
+		level = next
+	}
+}
+
+func (tree *LinkedListTree) _reverseLevelOrderVisit(fn func(*LinkedListTree, int) int) {
+	levels := [][]*LinkedListTree{}
+	for level := []*LinkedListTree{tree}; len(level) > 0; {
+		levels = append(levels, level)
+		next := []*LinkedListTree{}
+		for _, node := range level {
+			next = _appendChildren(next, node)
+		}
+		level = next
+	}
+	for depth := len(levels) - 1; depth >= 0; depth-- {
+		for _, node := range levels[depth] {
+			if fn(node, depth) == VISIT_STOP {
+				return
+			}
+		}
+	}
+}
+
+// _appendChildren appends the children of node to nodes from left to right
+func _appendChildren(nodes []*LinkedListTree, node *LinkedListTree) []*LinkedListTree {
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		nodes = append(nodes, child)
+	}
+	return nodes
+}
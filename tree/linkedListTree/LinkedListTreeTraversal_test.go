@@ -0,0 +1,191 @@
+package linkedListTree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/yuhlau/go-data-structures/tree"
+)
+
+// visitVals visits the unbalanced tree with the method and returns the values
+// visited, action decides what to do after visiting each value
+func visitVals(method int, action func(val interface{}) int) []interface{} {
+	vals := []interface{}{}
+	newUnbalancedTree().Visit(func(node *LinkedListTree, depth int) int {
+		vals = append(vals, node.Val())
+		return action(node.Val())
+	}, method)
+	return vals
+}
+
+func TestVisitReceivesNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := newUnbalancedTree()
+	visited := 0
+	err := tree.Visit(func(node *LinkedListTree, depth int) int {
+		visited++
+		if depth == 0 {
+			assert.Equal(tree, node)
+			return VISIT_CONTINUE
+		}
+		// Every node is reachable from its parent
+		found := false
+		for child := node.Parent().FirstChild(); child != nil; child = child.NextSibling() {
+			found = found || child == node
+		}
+		assert.Equal(true, found)
+		return VISIT_CONTINUE
+	}, TRAVERSAL_PRE_ORDER)
+	assert.Nil(err)
+	assert.Equal(12, visited)
+
+	err = tree.Visit(func(*LinkedListTree, int) int { return VISIT_CONTINUE }, -1)
+	assert.Equal("Unsupported traversal method", err.Error())
+}
+
+func TestVisitStop(t *testing.T) {
+	assert := assert.New(t)
+
+	stopAt := func(stop interface{}) func(interface{}) int {
+		return func(val interface{}) int {
+			if val == stop {
+				return VISIT_STOP
+			}
+			return VISIT_CONTINUE
+		}
+	}
+
+	assert.Equal([]interface{}{1, 2, 3, 4}, visitVals(TRAVERSAL_PRE_ORDER, stopAt(4)))
+	assert.Equal([]interface{}{5, 6, 4}, visitVals(TRAVERSAL_POST_ORDER, stopAt(4)))
+	assert.Equal([]interface{}{5, 4}, visitVals(TRAVERSAL_IN_ORDER, stopAt(4)))
+	assert.Equal([]interface{}{1, 2, 7}, visitVals(TRAVERSAL_LEVEL_ORDER, stopAt(7)))
+	assert.Equal([]interface{}{5, 6, 4}, visitVals(TRAVERSAL_REVERSE_LEVEL_ORDER, stopAt(4)))
+}
+
+func TestVisitSkipChildren(t *testing.T) {
+	assert := assert.New(t)
+
+	skip := func(val interface{}) int {
+		if val == 2 || val == 8 {
+			return VISIT_SKIP_CHILDREN
+		}
+		return VISIT_CONTINUE
+	}
+
+	assert.Equal(
+		[]interface{}{1, 2, 7, 8},
+		visitVals(TRAVERSAL_PRE_ORDER, skip),
+	)
+	assert.Equal(
+		[]interface{}{1, 2, 7, 8},
+		visitVals(TRAVERSAL_LEVEL_ORDER, skip),
+	)
+	// The first child is visited before the node in in-order
+	assert.Equal(
+		[]interface{}{5, 4, 6, 3, 2, 1, 7, 9, 8},
+		visitVals(TRAVERSAL_IN_ORDER, skip),
+	)
+	// The children are visited before the node in post-order
+	assert.Equal(
+		[]interface{}{5, 6, 4, 3, 2, 7, 9, 11, 10, 12, 8, 1},
+		visitVals(TRAVERSAL_POST_ORDER, skip),
+	)
+	assert.Equal(
+		[]interface{}{5, 6, 4, 11, 3, 9, 10, 12, 2, 7, 8, 1},
+		visitVals(TRAVERSAL_REVERSE_LEVEL_ORDER, skip),
+	)
+}
+
+func TestVisitSearch(t *testing.T) {
+	assert := assert.New(t)
+
+	// Find the first node deeper than 2 and stop the search there
+	var found *LinkedListTree
+	newUnbalancedTree().Visit(func(node *LinkedListTree, depth int) int {
+		if depth > 2 {
+			found = node
+			return VISIT_STOP
+		}
+		return VISIT_CONTINUE
+	}, TRAVERSAL_LEVEL_ORDER)
+	assert.Equal(4, found.Val())
+	assert.Equal(3, found.Parent().Val())
+	assert.Nil(found.NextSibling())
+}
+
+func TestVisitMillionLevels(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping million level tree in short mode")
+	}
+	assert := assert.New(t)
+
+	const depth = 1000000
+	tree := newChainTree(depth)
+
+	testCases := []struct {
+		method int
+		first  int
+	}{
+		{TRAVERSAL_PRE_ORDER, 0},
+		{TRAVERSAL_POST_ORDER, depth - 1},
+		{TRAVERSAL_IN_ORDER, depth - 1},
+		{TRAVERSAL_LEVEL_ORDER, 0},
+		{TRAVERSAL_REVERSE_LEVEL_ORDER, depth - 1},
+	}
+
+	for _, testCase := range testCases {
+		count := 0
+		// Values run in order from first, as the depth of each node in a chain
+		// is also its value
+		expected, step := testCase.first, 1
+		if testCase.first != 0 {
+			step = -1
+		}
+		ok := true
+		tree.Visit(func(node *LinkedListTree, d int) int {
+			ok = ok && node.Val() == expected && d == expected
+			expected += step
+			count++
+			return VISIT_CONTINUE
+		}, testCase.method)
+		assert.Equal(true, ok, "method %d", testCase.method)
+		assert.Equal(depth, count, "method %d", testCase.method)
+	}
+}
+
+func TestVisitMillionLevelsEarlyExit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping million level tree in short mode")
+	}
+	assert := assert.New(t)
+
+	tree := newChainTree(1000000)
+
+	count := 0
+	tree.Visit(func(node *LinkedListTree, depth int) int {
+		count++
+		if depth == 10 {
+			return VISIT_SKIP_CHILDREN
+		}
+		return VISIT_CONTINUE
+	}, TRAVERSAL_PRE_ORDER)
+	assert.Equal(11, count)
+
+	count = 0
+	for node := range tree.InOrder() {
+		count++
+		if node.Val() == 999990 {
+			break
+		}
+	}
+	assert.Equal(10, count)
+}
+
+func BenchmarkPreOrderChain(b *testing.B) {
+	tree := newChainTree(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Visit(func(*LinkedListTree, int) int { return VISIT_CONTINUE }, TRAVERSAL_PRE_ORDER)
+	}
+}
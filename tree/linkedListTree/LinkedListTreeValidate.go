@@ -0,0 +1,121 @@
+package linkedListTree
+
+import (
+	"fmt"
+)
+
+// Invariants checked by Validate
+const (
+	// INVARIANT_ACYCLIC requires every node to be reachable through one path
+	// only, following either the parent or the child and sibling links
+	INVARIANT_ACYCLIC = "node is reachable through more than one path"
+	// INVARIANT_CHILD_PARENT requires every child to point back to its parent,
+	// so that all siblings share the same parent
+	INVARIANT_CHILD_PARENT = "child does not point to its parent"
+	// INVARIANT_PREV_SIBLING requires the previous sibling of every child to be
+	// the child linked before it, nil for the first child
+	INVARIANT_PREV_SIBLING = "previous sibling does not match the sibling before"
+	// INVARIANT_ROOT_SIBLING requires a root to have no sibling
+	INVARIANT_ROOT_SIBLING = "root has a sibling"
+	// INVARIANT_LINKED_TO_PARENT requires a node to be among the children of
+	// its parent
+	INVARIANT_LINKED_TO_PARENT = "node is not among the children of its parent"
+)
+
+// DebugValidation makes every structural mutation (AppendChild, AppendSibling,
+// Detach, MoveTo, InsertChildAt, InsertSiblingBefore, InsertSiblingAfter,
+// ReplaceWith and AdoptChildren) validate the trees it touched afterwards and
+// panic with the *ValidationError if an invariant is broken. It is meant to be
+// switched on in tests. The Set* methods are not checked, as relinking nodes
+// by hand goes through invalid states; call Validate once done instead
+var DebugValidation = false
+
+// ValidationError reports the node at which Validate found an invariant to be
+// broken
+type ValidationError struct {
+	Node      *LinkedListTree
+	Invariant string
+}
+
+func (err *ValidationError) Error() string {
+	return fmt.Sprintf("Invalid tree at node %v: %s", err.Node.Val(), err.Invariant)
+}
+
+// Validate checks the links between the tree, its ancestors and all its
+// descendants, and returns a *ValidationError for the first broken invariant
+// found, nil if the structure is consistent
+func (tree *LinkedListTree) Validate() error {
+	seen := map[*LinkedListTree]bool{tree: true}
+	for node := tree.parent; node != nil; node = node.parent {
+		if seen[node] {
+			return &ValidationError{node, INVARIANT_ACYCLIC}
+		}
+		seen[node] = true
+	}
+
+	if tree.parent == nil {
+		if tree.prevSibling != nil || tree.nextSibling != nil {
+			return &ValidationError{tree, INVARIANT_ROOT_SIBLING}
+		}
+	} else if !tree._isLinkedToParent() {
+		return &ValidationError{tree, INVARIANT_LINKED_TO_PARENT}
+	}
+
+	visited := map[*LinkedListTree]bool{tree: true}
+	pending := []*LinkedListTree{tree}
+	for len(pending) > 0 {
+		node := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		var prev *LinkedListTree
+		for child := node.firstChild; child != nil; child = child.nextSibling {
+			if visited[child] {
+				return &ValidationError{child, INVARIANT_ACYCLIC}
+			}
+			visited[child] = true
+			if child.parent != node {
+				return &ValidationError{child, INVARIANT_CHILD_PARENT}
+			}
+			if child.prevSibling != prev {
+				return &ValidationError{child, INVARIANT_PREV_SIBLING}
+			}
+			pending = append(pending, child)
+			prev = child
+		}
+	}
+	return nil
+}
+
+// _isLinkedToParent returns whether the tree is among the children of its
+// parent, stopping if the siblings loop
+func (tree *LinkedListTree) _isLinkedToParent() bool {
+	seen := map[*LinkedListTree]bool{}
+	for child := tree.parent.firstChild; child != nil && !seen[child]; child = child.nextSibling {
+		if child == tree {
+			return true
+		}
+		seen[child] = true
+	}
+	return false
+}
+
+// _debugValidate validates the whole trees containing the nodes when
+// DebugValidation is on, and panics on the first error
+func _debugValidate(nodes ...*LinkedListTree) {
+	if !DebugValidation {
+		return
+	}
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+		// Validate the node first so that Root cannot loop on its ancestors
+		err := node.Validate()
+		if err == nil {
+			err = node.Root().Validate()
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package linkedListTree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// enableDebugValidation turns DebugValidation on for the duration of the test
+func enableDebugValidation(t *testing.T) {
+	DebugValidation = true
+	t.Cleanup(func() { DebugValidation = false })
+}
+
+func assertViolation(assert *assert.Assertions, err error, node *LinkedListTree, invariant string) {
+	validationErr, ok := err.(*ValidationError)
+	if assert.Equal(true, ok, "expected a *ValidationError, got %v", err) {
+		assert.Equal(node, validationErr.Node)
+		assert.Equal(invariant, validationErr.Invariant)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := newUnbalancedTree()
+	assert.Nil(tree.Validate())
+	for node := range tree.PreOrder() {
+		assert.Nil(node.Validate())
+	}
+	assert.Nil(NewLinkedListTree(1).Validate())
+}
+
+func TestValidateChildCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := newSampleTree()
+	leaf := tree.FirstChild().FirstChild()
+	leaf.SetFirstChild(tree.FirstChild())
+	assertViolation(assert, tree.Validate(), tree.FirstChild(), INVARIANT_ACYCLIC)
+}
+
+func TestValidateSiblingCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := newSampleTree()
+	last := tree.FirstChild().NextSibling()
+	last.SetNextSibling(tree.FirstChild())
+	assertViolation(assert, tree.Validate(), tree.FirstChild(), INVARIANT_ACYCLIC)
+}
+
+func TestValidateParentCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	a := NewLinkedListTree(1)
+	b := NewLinkedListTree(2)
+	a.SetParent(b)
+	b.SetParent(a)
+	assertViolation(assert, a.Validate(), a, INVARIANT_ACYCLIC)
+}
+
+func TestValidateSiblingsWithDifferentParents(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := newSampleTree()
+	other := NewLinkedListTree(9)
+	stray := NewLinkedListTreeNode(7, other)
+	stray.SetPrevSibling(tree.FirstChild().NextSibling())
+	tree.FirstChild().NextSibling().SetNextSibling(stray)
+	assertViolation(assert, tree.Validate(), stray, INVARIANT_CHILD_PARENT)
+}
+
+func TestValidatePrevSibling(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := newSampleTree()
+	second := tree.FirstChild().NextSibling()
+	second.SetPrevSibling(nil)
+	assertViolation(assert, tree.Validate(), second, INVARIANT_PREV_SIBLING)
+
+	second.SetPrevSibling(second.FirstChild())
+	assertViolation(assert, tree.Validate(), second, INVARIANT_PREV_SIBLING)
+}
+
+func TestValidateRootSibling(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := newSampleTree()
+	tree.prevSibling = tree.FirstChild()
+	assertViolation(assert, tree.Validate(), tree, INVARIANT_ROOT_SIBLING)
+}
+
+func TestValidateLinkedToParent(t *testing.T) {
+	assert := assert.New(t)
+
+	tree := newSampleTree()
+	node := NewLinkedListTreeNode(7, tree)
+	assertViolation(assert, node.Validate(), node, INVARIANT_LINKED_TO_PARENT)
+	// The parent itself is still consistent
+	assert.Nil(tree.Validate())
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	err := &ValidationError{NewLinkedListTree(7), INVARIANT_ACYCLIC}
+	assert.Equal("Invalid tree at node 7: node is reachable through more than one path", err.Error())
+}
+
+func TestDebugValidationPanicsOnCorruptedTree(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	tree := newSampleTree()
+	// Corrupt the tree by hand, then mutate it through the API
+	tree.FirstChild().NextSibling().SetPrevSibling(nil)
+
+	defer func() {
+		err, ok := recover().(*ValidationError)
+		assert.Equal(true, ok)
+		assert.Equal(INVARIANT_PREV_SIBLING, err.Invariant)
+	}()
+	tree.AppendChild(7)
+	assert.Fail("AppendChild should have panicked")
+}
+
+func TestDebugValidationMutations(t *testing.T) {
+	assert := assert.New(t)
+	enableDebugValidation(t)
+
+	// None of these panic as every mutation keeps the tree consistent
+	tree := newUnbalancedTree()
+	two := tree.FirstChild()
+	eight := two.NextSibling().NextSibling()
+	assert.Nil(two.FirstChild().MoveTo(eight, 1))
+	_, err := eight.InsertChildAt(0, 13)
+	assert.Nil(err)
+	_, err = eight.InsertSiblingBefore(14)
+	assert.Nil(err)
+	_, err = two.InsertSiblingAfter(15)
+	assert.Nil(err)
+	_, err = two.AppendSibling(16)
+	assert.Nil(err)
+	assert.Nil(eight.ReplaceWith(eight.FirstChild()))
+	assert.Nil(tree.AdoptChildren(eight))
+	two.Detach()
+	assert.Nil(tree.Validate())
+	assert.Nil(eight.Validate())
+}
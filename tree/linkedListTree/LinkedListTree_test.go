@@ -204,7 +204,7 @@ func TestNextChild(t *testing.T) {
 	assert.Equal(child, tree.FirstChild())
 }
 
-func ExamplePreOrderTraverse() {
+func ExampleLinkedListTree_Traverse_preOrder() {
 	tree := NewLinkedListTree(1)
 	child := tree.AppendChild(2)
 	child.AppendChild(7)
@@ -255,7 +255,7 @@ func ExamplePreOrderTraverse() {
 	//         20
 }
 
-func ExamplePostOrderTraverse() {
+func ExampleLinkedListTree_Traverse_postOrder() {
 	tree := NewLinkedListTree(1)
 	child := tree.AppendChild(2)
 	child.AppendChild(7)
@@ -324,3 +324,243 @@ func TestDelete(t *testing.T) {
 	child.Delete()
 	assert.Nil(tree.FirstChild().NextSibling())
 }
+
+// newSampleTree builds the tree
+//
+//	1
+//	├── 2
+//	│   ├── 4
+//	│   └── 5
+//	└── 3
+//	    └── 6
+func newSampleTree() *LinkedListTree {
+	tree := NewLinkedListTree(1)
+	child := tree.AppendChild(2)
+	child.AppendChild(4)
+	child.AppendChild(5)
+	child = tree.AppendChild(3)
+	child.AppendChild(6)
+	return tree
+}
+
+func collect(seq func(func(*LinkedListTree, int) bool)) ([]interface{}, []int) {
+	vals := []interface{}{}
+	depths := []int{}
+	for node, depth := range seq {
+		vals = append(vals, node.Val())
+		depths = append(depths, depth)
+	}
+	return vals, depths
+}
+
+func TestPreOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, depths := collect(newSampleTree().PreOrder())
+	assert.Equal([]interface{}{1, 2, 4, 5, 3, 6}, vals)
+	assert.Equal([]int{0, 1, 2, 2, 1, 2}, depths)
+
+	vals = []interface{}{}
+	for node := range newSampleTree().PreOrder() {
+		if node.Val() == 3 {
+			break
+		}
+		vals = append(vals, node.Val())
+	}
+	assert.Equal([]interface{}{1, 2, 4, 5}, vals)
+}
+
+func TestPostOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, depths := collect(newSampleTree().PostOrder())
+	assert.Equal([]interface{}{4, 5, 2, 6, 3, 1}, vals)
+	assert.Equal([]int{2, 2, 1, 2, 1, 0}, depths)
+
+	vals = []interface{}{}
+	for node := range newSampleTree().PostOrder() {
+		if node.Val() == 2 {
+			break
+		}
+		vals = append(vals, node.Val())
+	}
+	assert.Equal([]interface{}{4, 5}, vals)
+}
+
+// newUnbalancedTree builds the tree
+//
+//	1
+//	├── 2
+//	│   └── 3
+//	│       └── 4
+//	│           ├── 5
+//	│           └── 6
+//	├── 7
+//	└── 8
+//	    ├── 9
+//	    ├── 10
+//	    │   └── 11
+//	    └── 12
+func newUnbalancedTree() *LinkedListTree {
+	tree := NewLinkedListTree(1)
+	node := tree.AppendChild(2).AppendChild(3).AppendChild(4)
+	node.AppendChild(5)
+	node.AppendChild(6)
+	tree.AppendChild(7)
+	node = tree.AppendChild(8)
+	node.AppendChild(9)
+	node.AppendChild(10).AppendChild(11)
+	node.AppendChild(12)
+	return tree
+}
+
+// newChainTree builds a tree where every node has exactly one child, the
+// value of each node being its depth
+func newChainTree(depth int) *LinkedListTree {
+	tree := NewLinkedListTree(0)
+	node := tree
+	for i := 1; i < depth; i++ {
+		node = node.AppendChild(i)
+	}
+	return tree
+}
+
+func TestTraverseUnbalanced(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		method int
+		vals   []interface{}
+		depths []int
+	}{
+		{
+			TRAVERSAL_PRE_ORDER,
+			[]interface{}{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			[]int{0, 1, 2, 3, 4, 4, 1, 1, 2, 2, 3, 2},
+		},
+		{
+			TRAVERSAL_POST_ORDER,
+			[]interface{}{5, 6, 4, 3, 2, 7, 9, 11, 10, 12, 8, 1},
+			[]int{4, 4, 3, 2, 1, 1, 2, 3, 2, 2, 1, 0},
+		},
+		{
+			TRAVERSAL_IN_ORDER,
+			[]interface{}{5, 4, 6, 3, 2, 1, 7, 9, 8, 11, 10, 12},
+			[]int{4, 3, 4, 2, 1, 0, 1, 2, 1, 3, 2, 2},
+		},
+		{
+			TRAVERSAL_LEVEL_ORDER,
+			[]interface{}{1, 2, 7, 8, 3, 9, 10, 12, 4, 11, 5, 6},
+			[]int{0, 1, 1, 1, 2, 2, 2, 2, 3, 3, 4, 4},
+		},
+		{
+			TRAVERSAL_REVERSE_LEVEL_ORDER,
+			[]interface{}{5, 6, 4, 11, 3, 9, 10, 12, 2, 7, 8, 1},
+			[]int{4, 4, 3, 3, 2, 2, 2, 2, 1, 1, 1, 0},
+		},
+	}
+
+	for _, testCase := range testCases {
+		vals := []interface{}{}
+		depths := []int{}
+		err := newUnbalancedTree().Traverse(func(val interface{}, depth int) {
+			vals = append(vals, val)
+			depths = append(depths, depth)
+		}, testCase.method)
+		assert.Nil(err)
+		assert.Equal(testCase.vals, vals, "method %d", testCase.method)
+		assert.Equal(testCase.depths, depths, "method %d", testCase.method)
+	}
+
+	err := newUnbalancedTree().Traverse(func(interface{}, int) {}, -1)
+	assert.Equal("Unsupported traversal method", err.Error())
+}
+
+func TestTraverseDeep(t *testing.T) {
+	assert := assert.New(t)
+
+	const depth = 10000
+	ascending := make([]interface{}, depth)
+	descending := make([]interface{}, depth)
+	for i := 0; i < depth; i++ {
+		ascending[i] = i
+		descending[depth-1-i] = i
+	}
+
+	testCases := []struct {
+		method int
+		vals   []interface{}
+	}{
+		{TRAVERSAL_PRE_ORDER, ascending},
+		{TRAVERSAL_POST_ORDER, descending},
+		{TRAVERSAL_IN_ORDER, descending},
+		{TRAVERSAL_LEVEL_ORDER, ascending},
+		{TRAVERSAL_REVERSE_LEVEL_ORDER, descending},
+	}
+
+	tree := newChainTree(depth)
+	for _, testCase := range testCases {
+		vals := make([]interface{}, 0, depth)
+		tree.Traverse(func(val interface{}, d int) {
+			// In a chain the depth of a node is its value
+			assert.Equal(val, d)
+			vals = append(vals, val)
+		}, testCase.method)
+		assert.Equal(testCase.vals, vals, "method %d", testCase.method)
+	}
+}
+
+func TestInOrderEarlyExit(t *testing.T) {
+	assert := assert.New(t)
+
+	vals := []interface{}{}
+	for node := range newUnbalancedTree().InOrder() {
+		if node.Val() == 1 {
+			break
+		}
+		vals = append(vals, node.Val())
+	}
+	assert.Equal([]interface{}{5, 4, 6, 3, 2}, vals)
+}
+
+func TestLevelOrderEarlyExit(t *testing.T) {
+	assert := assert.New(t)
+
+	vals := []interface{}{}
+	for node, depth := range newUnbalancedTree().LevelOrder() {
+		if depth == 2 {
+			break
+		}
+		vals = append(vals, node.Val())
+	}
+	assert.Equal([]interface{}{1, 2, 7, 8}, vals)
+
+	vals = []interface{}{}
+	for node, depth := range newUnbalancedTree().ReverseLevelOrder() {
+		if depth == 2 {
+			break
+		}
+		vals = append(vals, node.Val())
+	}
+	assert.Equal([]interface{}{5, 6, 4, 11}, vals)
+}
+
+func ExampleLinkedListTree_Traverse_levelOrder() {
+	tree := NewLinkedListTree(1)
+	child := tree.AppendChild(2)
+	child.AppendChild(4)
+	child.AppendChild(5)
+	child = tree.AppendChild(3)
+	child.AppendChild(6)
+
+	tree.Traverse(func(val interface{}, depth int) {
+		fmt.Println(depth, val)
+	}, TRAVERSAL_LEVEL_ORDER)
+	// Output:
+	// 0 1
+	// 1 2
+	// 1 3
+	// 2 4
+	// 2 5
+	// 2 6
+}
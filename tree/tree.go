@@ -4,4 +4,21 @@ const (
 	TRAVERSAL_PRE_ORDER = iota
 	TRAVERSAL_POST_ORDER
 	TRAVERSAL_IN_ORDER
+	// TRAVERSAL_LEVEL_ORDER visits the nodes breadth-first, level by level from
+	// the root, each level from left to right
+	TRAVERSAL_LEVEL_ORDER
+	// TRAVERSAL_REVERSE_LEVEL_ORDER visits the levels from the deepest one up
+	// to the root, each level from left to right
+	TRAVERSAL_REVERSE_LEVEL_ORDER
+)
+
+// Actions returned by a visitor to control the traversal
+const (
+	// VISIT_CONTINUE continues the traversal normally
+	VISIT_CONTINUE = iota
+	// VISIT_SKIP_CHILDREN continues the traversal without visiting the children
+	// of the current node which have not been visited yet
+	VISIT_SKIP_CHILDREN
+	// VISIT_STOP ends the traversal immediately
+	VISIT_STOP
 )